@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/account"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetMe returns session player, with his username if registered.
+func (handler *GameHandler) HandleGetMe(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: current player")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{sessionPlayer(request)},
+	})
+}
+
+// HandleGetMyExport returns everything stored about session player, as a JSON file.
+func (handler *GameHandler) HandleGetMyExport(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: player data export")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	export, err := handler.accountService.Export(sessionPlayer(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while exporting player data", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Disposition", `attachment; filename="falloutdle-export.json"`)
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{export},
+	})
+}
+
+// /----- HTTP POST -----/
+
+// HandlePostRegister turns session anonymous player into an account, keeping his history.
+func (handler *GameHandler) HandlePostRegister(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: register")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var credentials CredentialsRequest
+	if err := decodeJSONRequest(request, &credentials); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	p := currentPlayer(request)
+	session, err := handler.accountService.Register(p, currentSession(request), credentials.Username, credentials.Password)
+
+	switch {
+	case errors.Is(err, player.ErrInvalidUsername), errors.Is(err, player.ErrInvalidPassword), errors.Is(err, player.ErrProfaneName):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, player.ErrUsernameTaken), errors.Is(err, player.ErrAlreadyRegistered):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while registering", http.StatusInternalServerError)
+		return
+	}
+
+	setSessionCookie(writer, handler.accountService.Cookie(session))
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{p},
+	})
+}
+
+// HandlePostLogin logs in an account, merging session anonymous player history into it.
+func (handler *GameHandler) HandlePostLogin(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: login")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var credentials CredentialsRequest
+	if err := decodeJSONRequest(request, &credentials); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	registered, session, err := handler.accountService.Login(
+		currentPlayer(request), currentSession(request), credentials.Username, credentials.Password, clientIP(request),
+	)
+
+	switch {
+	case errors.Is(err, player.ErrInvalidCredentials):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusUnauthorized)
+		return
+	case errors.Is(err, account.ErrTooManyAttempts):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusTooManyRequests)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while logging in", http.StatusInternalServerError)
+		return
+	}
+
+	setSessionCookie(writer, handler.accountService.Cookie(session))
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{registered},
+	})
+}
+
+// HandlePostProfile sets session player display name and leaderboards opt-out.
+func (handler *GameHandler) HandlePostProfile(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: profile")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var profile ProfileRequest
+	if err := decodeJSONRequest(request, &profile); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	p := currentPlayer(request)
+	err := handler.accountService.UpdateProfile(p, profile.DisplayName, profile.Hidden)
+
+	switch {
+	case errors.Is(err, player.ErrInvalidName), errors.Is(err, player.ErrProfaneName):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while updating profile", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{p},
+	})
+}
+
+// HandlePostDeleteMe deletes everything stored about session player, and ends his session.
+func (handler *GameHandler) HandlePostDeleteMe(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: player data deletion")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var deleteRequest DeleteRequest
+	if err := decodeJSONRequest(request, &deleteRequest); err != nil || !deleteRequest.Confirm {
+		sendErrorResponse(writer, CodeNotConfirmed, "Deletion must be confirmed", http.StatusBadRequest)
+		return
+	}
+
+	// Nothing is stored about a request without player
+	if p := currentPlayer(request); p != nil {
+		if err := handler.accountService.Delete(p); err != nil {
+			sendErrorResponse(writer, CodeInternalError, "Error while deleting player data", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	clearSessionCookie(writer)
+	sendJSONResponse(writer, Response{
+		Success: true,
+	})
+}
+
+// HandlePostLogout ends session, next saved play starts a new anonymous player.
+func (handler *GameHandler) HandlePostLogout(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: logout")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := handler.accountService.Logout(currentSession(request)); err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while logging out", http.StatusInternalServerError)
+		return
+	}
+
+	clearSessionCookie(writer)
+	sendJSONResponse(writer, Response{
+		Success: true,
+	})
+}
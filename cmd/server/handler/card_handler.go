@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"image/png"
+	"net/http"
+	"strings"
+
+	"github.com/doruo/falloutdle/internal/stats"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetCard returns a finished puzzle share card as PNG image, from its signed file name.
+// Cards never change once rendered, they are cached by clients and social networks previews.
+func (handler *GameHandler) HandleGetCard(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: share card")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, found := strings.CutSuffix(request.PathValue("file"), ".png")
+	if !found {
+		sendErrorResponse(writer, CodeCardNotFound, "Card not found", http.StatusNotFound)
+		return
+	}
+
+	card, err := handler.gameService.GetCard(token)
+
+	switch {
+	case errors.Is(err, stats.ErrInvalidToken), errors.Is(err, stats.ErrResultNotFound):
+		sendErrorResponse(writer, CodeCardNotFound, "Card not found", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while rendering card", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "image/png")
+	// Cached for a while only, so that cards of deleted players expire
+	writer.Header().Set("Cache-Control", "public, max-age=3600")
+
+	if err := png.Encode(writer, card); err != nil {
+		fmt.Println(time.Today(), "API - card encoding error:", err)
+	}
+}
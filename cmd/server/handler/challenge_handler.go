@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/challenge"
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetChallenge returns challenge from token, without its character.
+func (handler *GameHandler) HandleGetChallenge(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: challenge")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetChallenge(currentPlayerID(request), request.PathValue("token"))
+
+	switch {
+	case errors.Is(err, challenge.ErrInvalidToken), errors.Is(err, challenge.ErrChallengeNotFound):
+		sendErrorResponse(writer, CodeChallengeNotFound, "Challenge not found", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while getting challenge", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
+
+// HandleGetChallengeStats returns challenge solves count to its creator.
+func (handler *GameHandler) HandleGetChallengeStats(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: challenge stats")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetChallengeStats(currentPlayerID(request), request.PathValue("token"))
+
+	switch {
+	case errors.Is(err, challenge.ErrNotCreator):
+		sendErrorResponse(writer, CodeNotCreator, "Only challenge creator can see its statistics", http.StatusForbidden)
+		return
+	case errors.Is(err, challenge.ErrInvalidToken), errors.Is(err, challenge.ErrChallengeNotFound):
+		sendErrorResponse(writer, CodeChallengeNotFound, "Challenge not found", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while getting challenge statistics", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
+
+// /----- HTTP POST -----/
+
+// HandlePostCreateChallenge creates a challenge on a chosen character and returns its link.
+func (handler *GameHandler) HandlePostCreateChallenge(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: create challenge")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var challengeRequest ChallengeRequest
+	if err := decodeJSONRequest(request, &challengeRequest); err != nil {
+		sendErrorResponse(writer, CodeInvalidRequest, "Invalid challenge request", http.StatusBadRequest)
+		return
+	}
+
+	link, err := handler.gameService.CreateChallenge(currentPlayerID(request), challengeRequest.CharacterID)
+
+	switch {
+	case errors.Is(err, character.ErrCharacterNotFound):
+		sendErrorResponse(writer, CodeCharacterNotFound, "Character not found", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInvalidRequest, "Error while creating challenge", http.StatusBadRequest)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{link},
+	})
+}
+
+// HandlePostGuessChallenge compares guessed character with challenge character.
+func (handler *GameHandler) HandlePostGuessChallenge(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: guess challenge")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var guess GuessRequest
+	if err := decodeJSONRequest(request, &guess); err != nil {
+		sendErrorResponse(writer, CodeInvalidRequest, "Invalid guess request", http.StatusBadRequest)
+		return
+	}
+
+	playerID := currentPlayerID(request)
+	result, err := handler.gameService.ProcessChallengeGuess(playerID, request.PathValue("token"), guess.Name)
+
+	var ambiguous *character.AmbiguousNameError
+
+	switch {
+	case errors.Is(err, game.ErrInvalidGuess), errors.Is(err, character.ErrInvalidName):
+		sendErrorResponse(writer, CodeInvalidRequest, "Guess name cannot be empty", http.StatusBadRequest)
+		return
+	case errors.Is(err, challenge.ErrInvalidToken), errors.Is(err, challenge.ErrChallengeNotFound):
+		sendErrorResponse(writer, CodeChallengeNotFound, "Challenge not found", http.StatusNotFound)
+		return
+	case errors.Is(err, game.ErrPuzzleFinished):
+		sendErrorResponse(writer, CodeChallengeSolved, "Challenge already solved", http.StatusConflict)
+		return
+	case errors.As(err, &ambiguous):
+		sendCandidatesResponse(writer, ambiguous.Candidates)
+		return
+	case errors.Is(err, character.ErrCharacterNotFound):
+		sendErrorResponse(writer, CodeCharacterNotFound, "Unknown character", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while processing guess", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{result},
+	})
+}
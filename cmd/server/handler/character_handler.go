@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+const (
+	searchDefaultLimit = 10 // Characters returned by search without limit
+	searchMaxLimit     = 25 // Most characters returned by search
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetCharacters returns a page of characters, filtered and sorted
+// (?game=FNV&race=Human&gender=&status=&affiliation=&sort=-name&limit=20&cursor=).
+func (handler *GameHandler) HandleGetCharacters(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: list characters")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := request.URL.Query()
+	filter := character.ListFilter{
+		Game:        query.Get("game"),
+		Race:        query.Get("race"),
+		Gender:      query.Get("gender"),
+		Status:      query.Get("status"),
+		Affiliation: query.Get("affiliation"),
+	}
+
+	sort := character.Sort(query.Get("sort"))
+	if sort == "" {
+		sort = character.SortByName
+	}
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = character.DefaultPageSize
+	}
+
+	page, err := handler.gameService.ListCharacters(filter, sort, query.Get("cursor"), limit)
+
+	switch {
+	case errors.Is(err, character.ErrInvalidSort):
+		sendErrorResponse(writer, CodeInvalidSort, "Invalid sort", http.StatusBadRequest)
+		return
+	case errors.Is(err, character.ErrInvalidCursor):
+		sendErrorResponse(writer, CodeInvalidCursor, "Invalid page cursor", http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while listing characters", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{page},
+	})
+}
+
+// HandleGetCharacter returns a character from its ID.
+func (handler *GameHandler) HandleGetCharacter(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: character")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := strconv.ParseUint(request.PathValue("id"), 10, 0)
+	if err != nil || id == 0 {
+		sendErrorResponse(writer, CodeCharacterNotFound, "Character not found", http.StatusNotFound)
+		return
+	}
+
+	view, err := handler.gameService.GetCharacter(uint(id))
+	sendCharacterResponse(writer, view, err)
+}
+
+// HandleGetCharacterByTitle returns a character from its wiki page title.
+func (handler *GameHandler) HandleGetCharacterByTitle(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: character by wiki title")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetCharacterByTitle(request.PathValue("wikiTitle"))
+	sendCharacterResponse(writer, view, err)
+}
+
+// HandleGetSearchCharacters returns characters best matching a name, for autocompletion (?q=arca&limit=10).
+func (handler *GameHandler) HandleGetSearchCharacters(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: search characters")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := request.URL.Query().Get("q")
+	if query == "" {
+		sendErrorResponse(writer, CodeInvalidQuery, "Missing search query", http.StatusBadRequest)
+		return
+	}
+
+	limit, err := strconv.Atoi(request.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = searchDefaultLimit
+	}
+
+	results, err := handler.gameService.SearchCharacters(query, min(limit, searchMaxLimit))
+
+	switch {
+	case errors.Is(err, character.ErrInvalidQuery):
+		sendErrorResponse(writer, CodeInvalidQuery, "Invalid search query", http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while searching characters", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{results},
+	})
+}
+
+// /----- UTILITY METHODS -----/
+
+// sendCharacterResponse sends character view, or its retrieval error.
+func sendCharacterResponse(writer http.ResponseWriter, view *character.View, err error) {
+
+	switch {
+	case errors.Is(err, character.ErrCharacterNotFound):
+		sendErrorResponse(writer, CodeCharacterNotFound, "Character not found", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while getting character", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
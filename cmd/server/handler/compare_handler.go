@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetCompare compares two characters from their IDs or names, as if a was guessed and b the answer (?a=&b=).
+func (handler *GameHandler) HandleGetCompare(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: compare characters")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	a, b := request.URL.Query().Get("a"), request.URL.Query().Get("b")
+	if a == "" || b == "" {
+		sendErrorResponse(writer, CodeInvalidRequest, "Missing characters to compare", http.StatusBadRequest)
+		return
+	}
+
+	result, err := handler.gameService.CompareAny(a, b)
+
+	var ambiguous *character.AmbiguousNameError
+
+	switch {
+	case errors.Is(err, character.ErrInvalidName):
+		sendErrorResponse(writer, CodeInvalidRequest, "Character name cannot be empty", http.StatusBadRequest)
+		return
+	case errors.As(err, &ambiguous):
+		sendCandidatesResponse(writer, ambiguous.Candidates)
+		return
+	case errors.Is(err, character.ErrCharacterNotFound):
+		sendErrorResponse(writer, CodeCharacterNotFound, "Character not found", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while comparing characters", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{result},
+	})
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetConnections returns today connections puzzle with player progress.
+func (handler *GameHandler) HandleGetConnections(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: connections puzzle")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetConnections(currentPlayerID(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting connections puzzle", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
+
+// /----- HTTP POST -----/
+
+// HandlePostVerifyConnections verifies a submitted group of today connections puzzle.
+func (handler *GameHandler) HandlePostVerifyConnections(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: verify connections group")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var submission ConnectionsRequest
+	if err := decodeJSONRequest(request, &submission); err != nil {
+		sendErrorResponse(writer, CodeInvalidRequest, "Invalid connections request", http.StatusBadRequest)
+		return
+	}
+
+	verdict, err := handler.gameService.VerifyConnections(currentPlayerID(request), submission.IDs)
+
+	switch {
+	case errors.Is(err, game.ErrPuzzleFinished):
+		sendErrorResponse(writer, CodePuzzleFinished, "Connections puzzle already finished", http.StatusConflict)
+		return
+	case errors.Is(err, game.ErrInvalidSubmission):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while verifying connections group", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{verdict},
+	})
+}
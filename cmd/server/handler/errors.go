@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/doruo/falloutdle/internal/account"
+	"github.com/doruo/falloutdle/internal/challenge"
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+// ErrorCode represents a machine-readable API error, stable across messages wording
+type ErrorCode string
+
+const (
+	// Requests
+	CodeMethodNotAllowed ErrorCode = "METHOD_NOT_ALLOWED"
+	CodeInvalidJSON      ErrorCode = "INVALID_JSON"
+	CodeInvalidRequest   ErrorCode = "INVALID_REQUEST"
+	CodeInternalError    ErrorCode = "INTERNAL_ERROR"
+
+	// Characters
+	CodeCharacterNotFound ErrorCode = "CHARACTER_NOT_FOUND"
+	CodeAmbiguousName     ErrorCode = "AMBIGUOUS_NAME"
+	CodeInvalidQuery      ErrorCode = "INVALID_QUERY"
+	CodeInvalidSort       ErrorCode = "INVALID_SORT"
+	CodeInvalidCursor     ErrorCode = "INVALID_CURSOR"
+
+	// Games
+	CodeInvalidMode       ErrorCode = "INVALID_MODE"
+	CodePuzzleFinished    ErrorCode = "PUZZLE_FINISHED"
+	CodePuzzleNotFinished ErrorCode = "PUZZLE_NOT_FINISHED"
+	CodeInvalidSubmission ErrorCode = "INVALID_SUBMISSION"
+	CodeInvalidChoice     ErrorCode = "INVALID_CHOICE"
+	CodeNoRoundInProgress ErrorCode = "NO_ROUND_IN_PROGRESS"
+	CodeCardNotFound      ErrorCode = "CARD_NOT_FOUND"
+
+	// Challenges
+	CodeChallengeNotFound ErrorCode = "CHALLENGE_NOT_FOUND"
+	CodeChallengeSolved   ErrorCode = "CHALLENGE_SOLVED"
+	CodeNotCreator        ErrorCode = "NOT_CHALLENGE_CREATOR"
+
+	// Players and accounts
+	CodeInvalidUsername    ErrorCode = "INVALID_USERNAME"
+	CodeInvalidPassword    ErrorCode = "INVALID_PASSWORD"
+	CodeInvalidName        ErrorCode = "INVALID_NAME"
+	CodeProfaneName        ErrorCode = "PROFANE_NAME"
+	CodeUsernameTaken      ErrorCode = "USERNAME_TAKEN"
+	CodeAlreadyRegistered  ErrorCode = "ALREADY_REGISTERED"
+	CodeInvalidCredentials ErrorCode = "INVALID_CREDENTIALS"
+	CodeInvalidTransfer    ErrorCode = "INVALID_TRANSFER"
+	CodeTooManyAttempts    ErrorCode = "TOO_MANY_ATTEMPTS"
+	CodeNotConfirmed       ErrorCode = "DELETION_NOT_CONFIRMED"
+
+	// Leagues
+	CodeLeagueNotFound ErrorCode = "LEAGUE_NOT_FOUND"
+	CodeMemberNotFound ErrorCode = "MEMBER_NOT_FOUND"
+	CodeNotMember      ErrorCode = "NOT_LEAGUE_MEMBER"
+	CodeNotAdmin       ErrorCode = "NOT_LEAGUE_ADMIN"
+	CodeRemoveAdmin    ErrorCode = "LEAGUE_ADMIN_REMOVAL"
+	CodeLeagueFull     ErrorCode = "LEAGUE_FULL"
+)
+
+// Codes of errors whose message is sent as is, checked in order
+var errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{character.ErrCharacterNotFound, CodeCharacterNotFound},
+	{character.ErrAmbiguousName, CodeAmbiguousName},
+	{character.ErrInvalidName, CodeInvalidRequest},
+	{game.ErrInvalidGuess, CodeInvalidRequest},
+	{game.ErrInvalidMode, CodeInvalidMode},
+	{game.ErrPuzzleFinished, CodePuzzleFinished},
+	{game.ErrPuzzleNotFinished, CodePuzzleNotFinished},
+	{game.ErrInvalidSubmission, CodeInvalidSubmission},
+	{challenge.ErrInvalidToken, CodeChallengeNotFound},
+	{challenge.ErrChallengeNotFound, CodeChallengeNotFound},
+	{challenge.ErrNotCreator, CodeNotCreator},
+	{stats.ErrInvalidToken, CodeCardNotFound},
+	{stats.ErrResultNotFound, CodeCardNotFound},
+	{player.ErrInvalidUsername, CodeInvalidUsername},
+	{player.ErrInvalidPassword, CodeInvalidPassword},
+	{player.ErrInvalidName, CodeInvalidName},
+	{player.ErrProfaneName, CodeProfaneName},
+	{player.ErrUsernameTaken, CodeUsernameTaken},
+	{player.ErrAlreadyRegistered, CodeAlreadyRegistered},
+	{player.ErrInvalidCredentials, CodeInvalidCredentials},
+	{player.ErrInvalidTransfer, CodeInvalidTransfer},
+	{account.ErrTooManyAttempts, CodeTooManyAttempts},
+	{league.ErrLeagueNotFound, CodeLeagueNotFound},
+	{league.ErrMemberNotFound, CodeMemberNotFound},
+	{league.ErrNotMember, CodeNotMember},
+	{league.ErrNotAdmin, CodeNotAdmin},
+	{league.ErrRemoveAdmin, CodeRemoveAdmin},
+	{league.ErrInvalidName, CodeInvalidName},
+	{league.ErrProfaneName, CodeProfaneName},
+	{league.ErrLeagueFull, CodeLeagueFull},
+}
+
+// errorCode returns the code of a known error, or internal error code.
+func errorCode(err error) ErrorCode {
+
+	for _, known := range errorCodes {
+		if errors.Is(err, known.err) {
+			return known.code
+		}
+	}
+
+	return CodeInternalError
+}
@@ -1,22 +1,37 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 
+	"github.com/doruo/falloutdle/internal/account"
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/database"
 	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/pkg/graphql"
 	"github.com/doruo/falloutdle/pkg/time"
 )
 
 type GameHandler struct {
-	gameService *game.GameService
+	gameService    *game.GameService
+	accountService *account.Service
+	leagueService  *league.Service
+	graphqlSchema  *graphql.Schema
 }
 
 func NewGameHandler() *GameHandler {
-	return &GameHandler{
-		gameService: game.GetServiceInstance(),
+
+	handler := &GameHandler{
+		gameService:    game.GetServiceInstance(),
+		accountService: account.GetServiceInstance(),
+		leagueService:  league.NewLeagueService(league.NewLeagueRepository(database.GetInstance())),
 	}
+	handler.graphqlSchema = handler.newGraphQLSchema()
+
+	return handler
 }
 
 // /----- HTTP GET -----/
@@ -28,7 +43,7 @@ func (handler *GameHandler) HandleGetHome(writer http.ResponseWriter, request *h
 
 	// Verify correct http method
 	if !isMethod(request.Method, http.MethodGet) {
-		sendErrorResponse(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
@@ -41,67 +56,145 @@ func (handler *GameHandler) HandleGetHome(writer http.ResponseWriter, request *h
 	sendHTMLResponse(writer, content)
 }
 
-// HandleGetTodayCharacter returns today guess character.
-func (handler *GameHandler) HandleGetTodayCharacter(writer http.ResponseWriter, request *http.Request) {
+// HandleGetRandomCharacter returns random character from fallout games.
+func (handler *GameHandler) HandleGetRandomCharacter(writer http.ResponseWriter, request *http.Request) {
 
-	fmt.Println("API - handling GET request: today character")
+	fmt.Println(time.Today(), "API - handling GET request: random character")
 
 	// Verify correct http method
 	if !isMethod(request.Method, http.MethodGet) {
-		sendErrorResponse(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	character, error := handler.gameService.GetCurrentCharacter()
+	character, error := handler.gameService.GetRandomCharacter()
 
 	if error != nil {
-		sendErrorResponse(writer, "Error while getting character", http.StatusInternalServerError)
+		sendErrorResponse(writer, CodeInternalError, "Error while getting character", http.StatusInternalServerError)
 		return
 	}
 
 	sendJSONResponse(writer, Response{
 		Success: true,
-		Data:    []any{character},
+		Data:    []any{character.View()},
 	})
 }
 
-// HandleGetRandomCharacter returns random character from fallout games.
-func (handler *GameHandler) HandleGetRandomCharacter(writer http.ResponseWriter, request *http.Request) {
+// HandleGetActorClue returns today actor mode clue.
+func (handler *GameHandler) HandleGetActorClue(writer http.ResponseWriter, request *http.Request) {
 
-	fmt.Println(time.Today(), "API - handling GET request: random character")
+	fmt.Println(time.Today(), "API - handling GET request: actor clue")
 
 	// Verify correct http method
 	if !isMethod(request.Method, http.MethodGet) {
-		sendErrorResponse(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	character, error := handler.gameService.GetRandomCharacter()
+	clue, err := handler.gameService.GetActorClue()
 
-	if error != nil {
-		sendErrorResponse(writer, "Error while getting character", http.StatusInternalServerError)
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting actor clue", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{clue},
+	})
+}
+
+// HandleGetGuesses returns player saved guesses on today puzzle of mode.
+func (handler *GameHandler) HandleGetGuesses(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: guesses")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mode := game.Mode(request.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = game.ClassicMode
+	}
+
+	if mode != game.ClassicMode && mode != game.ActorMode {
+		sendErrorResponse(writer, CodeInvalidMode, "Invalid game mode", http.StatusBadRequest)
+		return
+	}
+
+	guesses, err := handler.gameService.GetGuesses(currentPlayerID(request), mode)
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting guesses", http.StatusInternalServerError)
 		return
 	}
 
 	sendJSONResponse(writer, Response{
 		Success: true,
-		Data:    []any{character},
+		Data:    []any{guesses},
 	})
 }
 
 // /----- HTTP POST -----/
 
+// HandlePostGuessCharacter compares guessed character with today classic mode character.
 func (handler *GameHandler) HandlePostGuessCharacter(writer http.ResponseWriter, request *http.Request) {
 
 	fmt.Println(time.Today(), "API - handling POST request: guess character")
+	handler.handleGuess(writer, request, game.ClassicMode)
+}
+
+// HandlePostGuessActor compares guessed character with today actor mode character.
+func (handler *GameHandler) HandlePostGuessActor(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: guess actor character")
+	handler.handleGuess(writer, request, game.ActorMode)
+}
+
+// handleGuess compares guessed character from request with today character of mode.
+func (handler *GameHandler) handleGuess(writer http.ResponseWriter, request *http.Request, mode game.Mode) {
 
 	// Verify correct http method
 	if !isMethod(request.Method, http.MethodPost) {
-		sendErrorResponse(writer, "Method not allowed", http.StatusMethodNotAllowed)
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
-	fmt.Println("guess character:", request.Body)
+	var guess GuessRequest
+	if err := decodeJSONRequest(request, &guess); err != nil {
+		sendErrorResponse(writer, CodeInvalidRequest, "Invalid guess request", http.StatusBadRequest)
+		return
+	}
+
+	result, err := handler.gameService.ProcessGuess(currentPlayerID(request), mode, guess.Name)
+
+	var ambiguous *character.AmbiguousNameError
+
+	switch {
+	case errors.Is(err, game.ErrInvalidGuess), errors.Is(err, character.ErrInvalidName):
+		sendErrorResponse(writer, CodeInvalidRequest, "Guess name cannot be empty", http.StatusBadRequest)
+		return
+	case errors.Is(err, game.ErrPuzzleFinished):
+		sendErrorResponse(writer, CodePuzzleFinished, "Puzzle already finished", http.StatusConflict)
+		return
+	case errors.As(err, &ambiguous):
+		sendCandidatesResponse(writer, ambiguous.Candidates)
+		return
+	case errors.Is(err, character.ErrCharacterNotFound):
+		sendErrorResponse(writer, CodeCharacterNotFound, "Unknown character", http.StatusNotFound)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while processing guess", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{result},
+	})
 }
 
 // /----- UTILITY METHODS -----/
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/pkg/graphql"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// HandleGraphQL executes a GraphQL query, sent as a POST JSON body or in GET parameters
+// (?query=&operationName=&variables=). Returns the schema definition on GET without query.
+func (handler *GameHandler) HandleGraphQL(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling request: GraphQL")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) && !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var graphqlRequest graphql.Request
+
+	if isMethod(request.Method, http.MethodPost) {
+		if err := decodeJSONRequest(request, &graphqlRequest); err != nil {
+			sendGraphQLResponse(writer, &graphql.Result{Errors: []*graphql.Error{{Message: "Invalid JSON body"}}})
+			return
+		}
+	} else {
+		query := request.URL.Query()
+
+		if query.Get("query") == "" {
+			writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			writer.Write([]byte(handler.graphqlSchema.String()))
+			return
+		}
+
+		graphqlRequest.Query = query.Get("query")
+		graphqlRequest.OperationName = query.Get("operationName")
+
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &graphqlRequest.Variables); err != nil {
+				sendGraphQLResponse(writer, &graphql.Result{Errors: []*graphql.Error{{Message: "Invalid variables"}}})
+				return
+			}
+		}
+	}
+
+	sendGraphQLResponse(writer, handler.graphqlSchema.Execute(request.Context(), graphqlRequest))
+}
+
+// sendGraphQLResponse sends GraphQL result in json format, as a bad request if it could not be executed.
+func sendGraphQLResponse(writer http.ResponseWriter, result *graphql.Result) {
+
+	writer.Header().Set("Content-Type", "application/json")
+	if result.Data == nil {
+		writer.WriteHeader(http.StatusBadRequest)
+	}
+
+	if err := json.NewEncoder(writer).Encode(result); err != nil {
+		http.Error(writer, "Failed to encode response", http.StatusInternalServerError)
+	}
+}
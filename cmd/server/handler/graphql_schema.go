@@ -0,0 +1,530 @@
+package handler
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/internal/stats"
+	"github.com/doruo/falloutdle/pkg/graphql"
+)
+
+// Most characters resolved by a GraphQL characters or search field
+const graphqlMaxCharacters = 50
+
+// newGraphQLSchema creates the GraphQL schema of characters, games, puzzles and session player stats.
+// Puzzle answers are only resolved from finished puzzles, so the schema can not reveal today answer.
+func (handler *GameHandler) newGraphQLSchema() *graphql.Schema {
+
+	gameCodes := make([]string, 0, len(character.AllGameCodes))
+	for _, code := range character.AllGameCodes {
+		gameCodes = append(gameCodes, string(code))
+	}
+
+	modes := make([]string, 0, len(game.AllModes))
+	for _, mode := range game.AllModes {
+		modes = append(modes, modeName(mode))
+	}
+
+	gameCodeEnum := &graphql.Enum{Name: "GameCode", Description: "Fallout game code", Values: gameCodes}
+	modeEnum := &graphql.Enum{Name: "Mode", Description: "Game mode", Values: modes}
+	sortEnum := &graphql.Enum{
+		Name:        "CharacterSort",
+		Description: "Characters listing order",
+		Values:      []string{"NAME", "NAME_DESC", "ID", "ID_DESC"},
+	}
+
+	nonNull := func(t graphql.Type) graphql.Type { return &graphql.NonNull{Of: t} }
+	listOf := func(t graphql.Type) graphql.Type {
+		return &graphql.NonNull{Of: &graphql.List{Of: &graphql.NonNull{Of: t}}}
+	}
+
+	gameType := &graphql.Object{
+		Name:        "Game",
+		Description: "Fallout game a character appears in",
+		Fields: []*graphql.Field{
+			{Name: "code", Type: nonNull(gameCodeEnum)},
+			{Name: "name", Type: nonNull(graphql.String)},
+			{Name: "releaseYear", Type: graphql.Int},
+		},
+	}
+
+	characterType := &graphql.Object{
+		Name:        "Character",
+		Description: "Fallout character as shown publicly",
+		Fields: []*graphql.Field{
+			{Name: "id", Type: nonNull(graphql.ID)},
+			{Name: "name", Type: nonNull(graphql.String)},
+			{Name: "wikiTitle", Type: nonNull(graphql.String)},
+			{Name: "games", Type: listOf(gameType)},
+			{Name: "mentions", Type: listOf(gameType), Description: "Games the character is only mentioned in"},
+			{Name: "mainGame", Type: gameType},
+			{Name: "race", Type: nonNull(graphql.String)},
+			{Name: "gender", Type: nonNull(graphql.String)},
+			{Name: "status", Type: nonNull(graphql.String)},
+			{Name: "affiliation", Type: listOf(graphql.String)},
+			{Name: "role", Type: nonNull(graphql.String)},
+			{Name: "titles", Type: listOf(graphql.String)},
+			{Name: "actors", Type: listOf(graphql.String)},
+			{Name: "aliases", Type: listOf(graphql.String)},
+			{Name: "imageUrl", Type: graphql.String},
+			{Name: "thumbnail", Type: graphql.String},
+		},
+	}
+
+	pageType := &graphql.Object{
+		Name:        "CharacterPage",
+		Description: "Page of listed characters",
+		Fields: []*graphql.Field{
+			{Name: "characters", Type: listOf(characterType)},
+			{Name: "nextCursor", Type: graphql.String, Description: "Cursor of next page, null on last page"},
+		},
+	}
+
+	filterType := &graphql.InputObject{
+		Name:        "CharacterFilter",
+		Description: "Characters listing filters, all matching",
+		Fields: []*graphql.Argument{
+			{Name: "game", Type: gameCodeEnum, Description: "Game the character appears in"},
+			{Name: "race", Type: graphql.String},
+			{Name: "gender", Type: graphql.String},
+			{Name: "status", Type: graphql.String},
+			{Name: "affiliation", Type: graphql.String},
+		},
+	}
+
+	pageArgs := func(filtered bool) []*graphql.Argument {
+		args := []*graphql.Argument{
+			{Name: "sort", Type: nonNull(sortEnum), Default: "NAME"},
+			{Name: "first", Type: nonNull(graphql.Int), Default: character.DefaultPageSize},
+			{Name: "after", Type: graphql.String, Description: "Cursor of previous page nextCursor"},
+		}
+		if filtered {
+			args = append([]*graphql.Argument{{Name: "filter", Type: filterType}}, args...)
+		}
+		return args
+	}
+
+	// Game characters are nested under games, filtered by game
+	gameType.Fields = append(gameType.Fields, &graphql.Field{
+		Name:        "characters",
+		Description: "Characters appearing in the game",
+		Type:        nonNull(pageType),
+		Args:        pageArgs(false),
+		Resolve: func(params graphql.ResolveParams) (any, error) {
+			filter := character.ListFilter{Game: params.Source.(map[string]any)["code"].(string)}
+			return handler.resolveCharacterPage(filter, params.Args)
+		},
+	})
+
+	distributionType := &graphql.Object{
+		Name:        "GuessCount",
+		Description: "Won puzzles count solved in a number of guesses",
+		Fields: []*graphql.Field{
+			{Name: "guesses", Type: nonNull(graphql.Int)},
+			{Name: "count", Type: nonNull(graphql.Int)},
+		},
+	}
+
+	statType := &graphql.Object{
+		Name:        "Stat",
+		Description: "Player statistics in a game mode",
+		Fields: []*graphql.Field{
+			{Name: "mode", Type: nonNull(modeEnum)},
+			{Name: "played", Type: nonNull(graphql.Int)},
+			{Name: "won", Type: nonNull(graphql.Int)},
+			{Name: "winPercentage", Type: nonNull(graphql.Int)},
+			{Name: "currentStreak", Type: nonNull(graphql.Int)},
+			{Name: "maxStreak", Type: nonNull(graphql.Int)},
+			{Name: "distribution", Type: listOf(distributionType)},
+		},
+	}
+
+	puzzleType := &graphql.Object{
+		Name:        "Puzzle",
+		Description: "Today puzzle of a mode, as played by session player",
+		Fields: []*graphql.Field{
+			{Name: "mode", Type: nonNull(modeEnum)},
+			{Name: "number", Type: nonNull(graphql.Int)},
+			{Name: "date", Type: nonNull(graphql.String), Description: "UTC day of the puzzle, as YYYY-MM-DD"},
+			{Name: "guesses", Type: nonNull(graphql.Int), Description: "Player guesses so far"},
+			{Name: "finished", Type: nonNull(graphql.Boolean)},
+			{Name: "won", Type: nonNull(graphql.Boolean)},
+			{Name: "answer", Type: characterType, Description: "Daily character, null until player finished the puzzle"},
+		},
+	}
+
+	playerType := &graphql.Object{
+		Name:        "Player",
+		Description: "Session player",
+		Fields: []*graphql.Field{
+			{Name: "name", Type: nonNull(graphql.String), Description: "Name shown to other players"},
+			{Name: "registered", Type: nonNull(graphql.Boolean)},
+			{
+				Name:        "stats",
+				Description: "Statistics in played modes, or only in mode",
+				Type:        listOf(statType),
+				Args:        []*graphql.Argument{{Name: "mode", Type: modeEnum}},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					views, err := handler.gameService.GetStats(params.Source.(map[string]any)["id"].(uint))
+					if err != nil {
+						return nil, errors.New("error while getting player stats")
+					}
+
+					objects := make([]map[string]any, 0, len(views))
+					for _, view := range views {
+						if !game.Mode(view.Mode).IsValid() {
+							continue
+						}
+						if mode := params.Args["mode"]; mode == nil || mode == modeName(game.Mode(view.Mode)) {
+							objects = append(objects, statObject(view))
+						}
+					}
+
+					return objects, nil
+				},
+			},
+		},
+	}
+
+	query := &graphql.Object{
+		Name: "Query",
+		Fields: []*graphql.Field{
+			{
+				Name:        "character",
+				Description: "Character from its ID or wiki page title, null if not found",
+				Type:        characterType,
+				Args: []*graphql.Argument{
+					{Name: "id", Type: graphql.ID},
+					{Name: "wikiTitle", Type: graphql.String},
+				},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					var view *character.View
+					var err error
+
+					switch {
+					case params.Args["id"] != nil:
+						id, parseErr := strconv.ParseUint(params.Args["id"].(string), 10, 0)
+						if parseErr != nil || id == 0 {
+							return nil, nil
+						}
+						view, err = handler.gameService.GetCharacter(uint(id))
+					case params.Args["wikiTitle"] != nil:
+						view, err = handler.gameService.GetCharacterByTitle(params.Args["wikiTitle"].(string))
+					default:
+						return nil, errors.New("expected an id or wikiTitle argument")
+					}
+
+					switch {
+					case errors.Is(err, character.ErrCharacterNotFound):
+						return nil, nil
+					case err != nil:
+						return nil, errors.New("error while getting character")
+					}
+
+					return characterObject(view), nil
+				},
+			},
+			{
+				Name:        "characters",
+				Description: "Page of characters, filtered and sorted",
+				Type:        nonNull(pageType),
+				Args:        pageArgs(true),
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					var filter character.ListFilter
+					if values, given := params.Args["filter"].(map[string]any); given {
+						filter.Game, _ = values["game"].(string)
+						filter.Race, _ = values["race"].(string)
+						filter.Gender, _ = values["gender"].(string)
+						filter.Status, _ = values["status"].(string)
+						filter.Affiliation, _ = values["affiliation"].(string)
+					}
+
+					return handler.resolveCharacterPage(filter, params.Args)
+				},
+			},
+			{
+				Name:        "search",
+				Description: "Characters best matching a name, alias or wiki title",
+				Type:        listOf(characterType),
+				Args: []*graphql.Argument{
+					{Name: "query", Type: nonNull(graphql.String)},
+					{Name: "first", Type: nonNull(graphql.Int), Default: searchDefaultLimit},
+				},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					first := min(max(params.Args["first"].(int), 1), searchMaxLimit)
+					results, err := handler.gameService.SearchCharacters(params.Args["query"].(string), first)
+
+					switch {
+					case errors.Is(err, character.ErrInvalidQuery):
+						return nil, errors.New("invalid search query")
+					case err != nil:
+						return nil, errors.New("error while searching characters")
+					}
+
+					ids := make([]uint, 0, len(results))
+					for _, result := range results {
+						ids = append(ids, result.ID)
+					}
+
+					return handler.resolveCharacters(ids)
+				},
+			},
+			{
+				Name:        "game",
+				Description: "Fallout game from its code",
+				Type:        nonNull(gameType),
+				Args:        []*graphql.Argument{{Name: "code", Type: nonNull(gameCodeEnum)}},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+					return gameObject(character.NewGameView(params.Args["code"].(string))), nil
+				},
+			},
+			{
+				Name:        "games",
+				Description: "Every Fallout game",
+				Type:        listOf(gameType),
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					objects := make([]map[string]any, 0, len(gameCodes))
+					for _, code := range gameCodes {
+						objects = append(objects, gameObject(character.NewGameView(code)))
+					}
+
+					return objects, nil
+				},
+			},
+			{
+				Name:        "puzzle",
+				Description: "Today puzzle of a daily mode, as played by session player",
+				Type:        nonNull(puzzleType),
+				Args:        []*graphql.Argument{{Name: "mode", Type: nonNull(modeEnum)}},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+					return handler.resolvePuzzle(contextPlayerID(params.Context), game.Mode(strings.ToLower(params.Args["mode"].(string))))
+				},
+			},
+			{
+				Name:        "puzzles",
+				Description: "Today puzzles of every daily mode, as played by session player",
+				Type:        listOf(puzzleType),
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					var objects []map[string]any
+					for _, mode := range game.AllModes {
+						if !mode.HasDailyPuzzle() {
+							continue
+						}
+
+						object, err := handler.resolvePuzzle(contextPlayerID(params.Context), mode)
+						if err != nil {
+							return nil, err
+						}
+						objects = append(objects, object)
+					}
+
+					return objects, nil
+				},
+			},
+			{
+				Name:        "me",
+				Description: "Session player",
+				Type:        nonNull(playerType),
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					// Requests without session read an empty anonymous player
+					p := contextPlayer(params.Context)
+					if p == nil {
+						p = player.NewPlayer()
+					}
+
+					return map[string]any{"id": p.ID, "name": p.PublicName(), "registered": p.IsRegistered()}, nil
+				},
+			},
+		},
+	}
+
+	return graphql.NewSchema(query)
+}
+
+// /----- RESOLVERS -----/
+
+// resolveCharacterPage returns a characters page object, from characters or game characters field arguments.
+func (handler *GameHandler) resolveCharacterPage(filter character.ListFilter, args map[string]any) (any, error) {
+
+	sorts := map[string]character.Sort{
+		"NAME":      character.SortByName,
+		"NAME_DESC": character.SortByNameDesc,
+		"ID":        character.SortByID,
+		"ID_DESC":   character.SortByIDDesc,
+	}
+
+	after, _ := args["after"].(string)
+	first := min(max(args["first"].(int), 1), graphqlMaxCharacters)
+
+	page, err := handler.gameService.ListCharacters(filter, sorts[args["sort"].(string)], after, first)
+
+	switch {
+	case errors.Is(err, character.ErrInvalidCursor):
+		return nil, errors.New("invalid page cursor")
+	case err != nil:
+		return nil, errors.New("error while listing characters")
+	}
+
+	ids := make([]uint, 0, len(page.Characters))
+	for _, item := range page.Characters {
+		ids = append(ids, item.ID)
+	}
+
+	characters, err := handler.resolveCharacters(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]any{"characters": characters, "nextCursor": nil}
+	if page.NextCursor != "" {
+		object["nextCursor"] = page.NextCursor
+	}
+
+	return object, nil
+}
+
+// resolveCharacters returns character objects from their IDs, in order.
+func (handler *GameHandler) resolveCharacters(ids []uint) ([]map[string]any, error) {
+
+	views, err := handler.gameService.GetCharacters(ids)
+	if err != nil {
+		return nil, errors.New("error while getting characters")
+	}
+
+	objects := make([]map[string]any, 0, len(views))
+	for _, view := range views {
+		objects = append(objects, characterObject(view))
+	}
+
+	return objects, nil
+}
+
+// resolvePuzzle returns today puzzle object of mode, with its answer only once player finished it.
+func (handler *GameHandler) resolvePuzzle(playerID uint, mode game.Mode) (map[string]any, error) {
+
+	view, err := handler.gameService.GetPuzzle(playerID, mode)
+
+	switch {
+	case errors.Is(err, game.ErrInvalidMode):
+		return nil, errors.New("mode has no daily puzzle")
+	case err != nil:
+		return nil, errors.New("error while getting puzzle")
+	}
+
+	object := map[string]any{
+		"mode":     modeName(view.Mode),
+		"number":   view.Number,
+		"date":     view.Date.Format("2006-01-02"),
+		"guesses":  view.Guesses,
+		"finished": view.Finished,
+		"won":      view.Won,
+		"answer":   nil,
+	}
+
+	if view.Finished && view.Answer != nil {
+		object["answer"] = characterObject(view.Answer)
+	}
+
+	return object, nil
+}
+
+// /----- OBJECTS -----/
+
+// characterObject returns character view as a GraphQL Character source.
+func characterObject(view *character.View) map[string]any {
+
+	object := map[string]any{
+		"id":          view.ID,
+		"name":        view.Name,
+		"wikiTitle":   view.WikiTitle,
+		"games":       gameObjects(view.Games),
+		"mentions":    gameObjects(view.Mentions),
+		"mainGame":    nil,
+		"race":        view.Race,
+		"gender":      view.Gender,
+		"status":      view.Status,
+		"affiliation": view.Affiliation,
+		"role":        view.Role,
+		"titles":      view.Titles,
+		"actors":      view.Actors,
+		"aliases":     view.Aliases,
+		"imageUrl":    nil,
+		"thumbnail":   nil,
+	}
+
+	if view.MainGame != nil {
+		object["mainGame"] = gameObject(*view.MainGame)
+	}
+	if view.ImageURL != "" {
+		object["imageUrl"] = view.ImageURL
+	}
+	if view.Thumbnail != "" {
+		object["thumbnail"] = view.Thumbnail
+	}
+
+	return object
+}
+
+// gameObject returns game view as a GraphQL Game source.
+func gameObject(view character.GameView) map[string]any {
+
+	object := map[string]any{"code": string(view.Code), "name": view.Name, "releaseYear": nil}
+	if view.ReleaseYear > 0 {
+		object["releaseYear"] = view.ReleaseYear
+	}
+
+	return object
+}
+
+// gameObjects returns game views as GraphQL Game sources.
+func gameObjects(views []character.GameView) []map[string]any {
+
+	objects := make([]map[string]any, 0, len(views))
+	for _, view := range views {
+		objects = append(objects, gameObject(view))
+	}
+
+	return objects
+}
+
+// statObject returns player stat as a GraphQL Stat source, its distribution by guesses count.
+func statObject(view stats.StatView) map[string]any {
+
+	guesses := make([]int, 0, len(view.Distribution))
+	for count := range view.Distribution {
+		guesses = append(guesses, count)
+	}
+	slices.Sort(guesses)
+
+	distribution := make([]map[string]any, 0, len(guesses))
+	for _, count := range guesses {
+		distribution = append(distribution, map[string]any{"guesses": count, "count": view.Distribution[count]})
+	}
+
+	return map[string]any{
+		"mode":          modeName(game.Mode(view.Mode)),
+		"played":        view.Played,
+		"won":           view.Won,
+		"winPercentage": view.WinPercentage,
+		"currentStreak": view.CurrentStreak,
+		"maxStreak":     view.MaxStreak,
+		"distribution":  distribution,
+	}
+}
+
+// modeName returns mode as a GraphQL Mode enum value. Rx: HIGHERLOWER
+func modeName(mode game.Mode) string {
+	return strings.ToUpper(string(mode))
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetHigherLower returns player current higher or lower round.
+func (handler *GameHandler) HandleGetHigherLower(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: higher or lower round")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetHigherLower(currentPlayerID(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting higher or lower round", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
+
+// /----- HTTP POST -----/
+
+// HandlePostAnswerHigherLower checks player choice on his current higher or lower round.
+func (handler *GameHandler) HandlePostAnswerHigherLower(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: higher or lower answer")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var answer HigherLowerRequest
+	if err := decodeJSONRequest(request, &answer); err != nil {
+		sendErrorResponse(writer, CodeInvalidRequest, "Invalid higher or lower request", http.StatusBadRequest)
+		return
+	}
+
+	result, err := handler.gameService.AnswerHigherLower(currentPlayerID(request), answer.Choice)
+
+	switch {
+	case errors.Is(err, game.ErrPuzzleFinished):
+		sendErrorResponse(writer, CodeNoRoundInProgress, "No higher or lower round in progress", http.StatusConflict)
+		return
+	case errors.Is(err, game.ErrInvalidSubmission):
+		sendErrorResponse(writer, CodeInvalidChoice, "Choice must be a or b", http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while answering higher or lower round", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{result},
+	})
+}
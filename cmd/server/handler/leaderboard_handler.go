@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/leaderboard"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetLeaderboard returns players ranking in a mode,
+// on today puzzle or all-time (?mode=classic&period=daily|alltime).
+func (handler *GameHandler) HandleGetLeaderboard(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: leaderboard")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mode := game.Mode(request.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = game.ClassicMode
+	}
+
+	period := leaderboard.Period(request.URL.Query().Get("period"))
+	if period == "" {
+		period = leaderboard.Daily
+	}
+
+	board, err := handler.gameService.GetLeaderboard(currentPlayerID(request), mode, period)
+
+	switch {
+	case errors.Is(err, game.ErrInvalidMode):
+		sendErrorResponse(writer, CodeInvalidMode, "Invalid game mode or period", http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while getting leaderboard", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{board},
+	})
+}
@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/leaderboard"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetMyLeagues returns leagues session player is member of.
+func (handler *GameHandler) HandleGetMyLeagues(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: player leagues")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	leagues, err := handler.leagueService.GetByPlayer(currentPlayerID(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting leagues", http.StatusInternalServerError)
+		return
+	}
+
+	data := make([]any, len(leagues))
+	for i, l := range leagues {
+		data[i] = l
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    data,
+	})
+}
+
+// HandleGetLeague returns league with its members statistics.
+func (handler *GameHandler) HandleGetLeague(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: league")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetLeague(currentPlayerID(request), leagueID(request))
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while getting league")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
+
+// HandleGetLeagueLeaderboard returns league members ranking in a mode,
+// on today puzzle or all-time (?mode=classic&period=daily|alltime).
+func (handler *GameHandler) HandleGetLeagueLeaderboard(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: league leaderboard")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mode := game.Mode(request.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = game.ClassicMode
+	}
+
+	period := leaderboard.Period(request.URL.Query().Get("period"))
+	if period == "" {
+		period = leaderboard.Daily
+	}
+
+	board, err := handler.gameService.GetLeagueLeaderboard(currentPlayerID(request), leagueID(request), mode, period)
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while getting league leaderboard")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{board},
+	})
+}
+
+// HandleGetLeagueStandings returns league members standings on this week puzzles of a mode (?mode=classic).
+func (handler *GameHandler) HandleGetLeagueStandings(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: league standings")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mode := game.Mode(request.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = game.ClassicMode
+	}
+
+	standings, err := handler.gameService.GetLeagueStandings(currentPlayerID(request), leagueID(request), mode)
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while getting league standings")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{standings},
+	})
+}
+
+// /----- HTTP POST -----/
+
+// HandlePostCreateLeague creates a league with session player as its admin.
+func (handler *GameHandler) HandlePostCreateLeague(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: create league")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var leagueRequest LeagueRequest
+	if err := decodeJSONRequest(request, &leagueRequest); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	created, err := handler.leagueService.Create(currentPlayerID(request), leagueRequest.Name)
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while creating league")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{created},
+	})
+}
+
+// HandlePostJoinLeague adds session player to league of an invite code.
+func (handler *GameHandler) HandlePostJoinLeague(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: join league")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var joinRequest JoinLeagueRequest
+	if err := decodeJSONRequest(request, &joinRequest); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	joined, err := handler.leagueService.Join(currentPlayerID(request), joinRequest.Code)
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while joining league")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{joined},
+	})
+}
+
+// HandlePostRenameLeague renames league, session player must be an admin.
+func (handler *GameHandler) HandlePostRenameLeague(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: rename league")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var leagueRequest LeagueRequest
+	if err := decodeJSONRequest(request, &leagueRequest); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	renamed, err := handler.leagueService.Rename(leagueID(request), currentPlayerID(request), leagueRequest.Name)
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while renaming league")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{renamed},
+	})
+}
+
+// HandlePostLeaveLeague removes session player from league.
+func (handler *GameHandler) HandlePostLeaveLeague(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: leave league")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := handler.leagueService.Leave(leagueID(request), currentPlayerID(request)); err != nil {
+		sendLeagueError(writer, err, "Error while leaving league")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+	})
+}
+
+// /----- HTTP DELETE -----/
+
+// HandleDeleteLeagueMember removes a member from league, session player must be an admin.
+func (handler *GameHandler) HandleDeleteLeagueMember(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling DELETE request: league member")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodDelete) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	memberID, _ := strconv.ParseUint(request.PathValue("member"), 10, 0)
+	err := handler.leagueService.RemoveMember(leagueID(request), currentPlayerID(request), uint(memberID))
+
+	if err != nil {
+		sendLeagueError(writer, err, "Error while removing league member")
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+	})
+}
+
+// /----- LEAGUE UTILS -----/
+
+// leagueID returns league ID from request path, 0 if invalid.
+func leagueID(request *http.Request) uint {
+	id, _ := strconv.ParseUint(request.PathValue("id"), 10, 0)
+	return uint(id)
+}
+
+// sendLeagueError sends league error with its http status, or message if unexpected.
+func sendLeagueError(writer http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, league.ErrLeagueNotFound), errors.Is(err, league.ErrMemberNotFound):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusNotFound)
+	case errors.Is(err, league.ErrNotMember), errors.Is(err, league.ErrNotAdmin), errors.Is(err, league.ErrRemoveAdmin):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusForbidden)
+	case errors.Is(err, league.ErrInvalidName), errors.Is(err, league.ErrProfaneName), errors.Is(err, game.ErrInvalidMode):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusBadRequest)
+	case errors.Is(err, league.ErrLeagueFull):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusConflict)
+	default:
+		sendErrorResponse(writer, CodeInternalError, message, http.StatusInternalServerError)
+	}
+}
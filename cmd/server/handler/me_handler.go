@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetMyStats returns session player statistics in all played modes.
+func (handler *GameHandler) HandleGetMyStats(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: player stats")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	stats, err := handler.gameService.GetStats(currentPlayerID(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting player stats", http.StatusInternalServerError)
+		return
+	}
+
+	data := make([]any, len(stats))
+	for i, stat := range stats {
+		data[i] = stat
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    data,
+	})
+}
+
+// HandleGetMyAchievements returns session player progress towards every achievement.
+func (handler *GameHandler) HandleGetMyAchievements(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: player achievements")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	progress, err := handler.gameService.GetAchievements(currentPlayerID(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting player achievements", http.StatusInternalServerError)
+		return
+	}
+
+	data := make([]any, len(progress))
+	for i, p := range progress {
+		data[i] = p
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    data,
+	})
+}
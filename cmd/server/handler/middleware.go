@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/database"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/pkg/openapi"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// SessionMiddleware loads request player from his session cookie
+type SessionMiddleware struct {
+	playerService *player.Service
+}
+
+func NewSessionMiddleware() *SessionMiddleware {
+
+	repo := player.NewPlayerRepository(database.GetInstance())
+
+	return &SessionMiddleware{
+		playerService: player.NewPlayerService(repo, player.NewDefaultCookieSigner()),
+	}
+}
+
+// WithSession loads request player from session cookie before calling next handler.
+// Requests without a valid cookie have no player, with ID 0, so reading never creates one.
+func (middleware *SessionMiddleware) WithSession(next http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+
+		if p, session, ok := middleware.loadSession(request); ok {
+			request = withPlayer(request, p, session)
+		}
+
+		next(writer, request)
+	}
+}
+
+// WithPlayer loads request player from session cookie before calling next handler.
+// Creates a new anonymous player if cookie is missing or invalid, for requests saving player history.
+func (middleware *SessionMiddleware) WithPlayer(next http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+
+		if p, session, ok := middleware.loadSession(request); ok {
+			next(writer, withPlayer(request, p, session))
+			return
+		}
+
+		p, session, err := middleware.playerService.NewAnonymous()
+		if err != nil {
+			fmt.Println(time.Today(), "API - session error:", err)
+			sendErrorResponse(writer, CodeInternalError, "Error while creating session", http.StatusInternalServerError)
+			return
+		}
+
+		setSessionCookie(writer, middleware.playerService.Cookie(session))
+		next(writer, withPlayer(request, p, session))
+	}
+}
+
+// loadSession returns request player and session from session cookie, if valid.
+func (middleware *SessionMiddleware) loadSession(request *http.Request) (*player.Player, *player.Session, bool) {
+
+	cookie, err := request.Cookie(sessionCookieName)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	p, session, err := middleware.playerService.GetByCookie(cookie.Value)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return p, session, true
+}
+
+// ValidationMiddleware rejects API requests not matching the OpenAPI document
+type ValidationMiddleware struct {
+	document *openapi.Document
+}
+
+// NewValidationMiddleware creates a new validation middleware from the embedded OpenAPI document.
+// Panics if the document is invalid, as the server could not describe its API.
+func NewValidationMiddleware() *ValidationMiddleware {
+
+	document, err := OpenAPISpec()
+	if err != nil {
+		panic(err)
+	}
+
+	return &ValidationMiddleware{document: document}
+}
+
+// WithValidation validates request parameters and body before calling next handler.
+func (middleware *ValidationMiddleware) WithValidation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+
+		var invalid *openapi.ValidationError
+		if err := middleware.document.ValidatePath(request, VersionedPath(request.URL.Path)); errors.As(err, &invalid) {
+
+			code := CodeInvalidRequest
+			if invalid.Status == http.StatusMethodNotAllowed {
+				code = CodeMethodNotAllowed
+			}
+
+			sendErrorResponse(writer, code, invalid.Message, invalid.Status)
+			return
+		}
+
+		next.ServeHTTP(writer, request)
+	})
+}
+
+// WithDeprecation marks responses of an unversioned API route as deprecated, pointing to its versioned route.
+func WithDeprecation(next http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+
+		writer.Header().Set("Deprecation", "true")
+		writer.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", VersionedPath(request.URL.Path)))
+
+		next(writer, request)
+	}
+}
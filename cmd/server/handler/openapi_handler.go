@@ -0,0 +1,37 @@
+package handler
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/pkg/openapi"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// OpenAPI 3 document describing every API route, must be updated along routes
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// OpenAPISpec returns the API OpenAPI document.
+func OpenAPISpec() (*openapi.Document, error) {
+	return openapi.Load(openAPISpec)
+}
+
+// /----- HTTP GET -----/
+
+// HandleGetOpenAPI returns the API OpenAPI document.
+func (handler *GameHandler) HandleGetOpenAPI(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: OpenAPI document")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Write(openAPISpec)
+}
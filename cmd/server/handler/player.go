@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/player"
+)
+
+type contextKey string
+
+// Request context keys of the session player and session
+const (
+	playerContextKey  contextKey = "player"
+	sessionContextKey contextKey = "session"
+)
+
+// Cookie holding the signed player session token
+const sessionCookieName = "falloutdle_session"
+
+// withPlayer returns request with player and his session in its context.
+func withPlayer(request *http.Request, p *player.Player, session *player.Session) *http.Request {
+	ctx := context.WithValue(request.Context(), playerContextKey, p)
+	return request.WithContext(context.WithValue(ctx, sessionContextKey, session))
+}
+
+// currentPlayer returns request session player, set by session middleware.
+func currentPlayer(request *http.Request) *player.Player {
+	return contextPlayer(request.Context())
+}
+
+// contextPlayer returns session player of a request context, nil if none.
+func contextPlayer(ctx context.Context) *player.Player {
+	p, _ := ctx.Value(playerContextKey).(*player.Player)
+	return p
+}
+
+// contextPlayerID returns session player ID of a request context, 0 if none.
+func contextPlayerID(ctx context.Context) uint {
+	if p := contextPlayer(ctx); p != nil {
+		return p.ID
+	}
+	return 0
+}
+
+// sessionPlayer returns request session player, or an unsaved anonymous one if request has none.
+func sessionPlayer(request *http.Request) *player.Player {
+	if p := currentPlayer(request); p != nil {
+		return p
+	}
+	return player.NewPlayer()
+}
+
+// currentSession returns request player session, set by session middleware.
+func currentSession(request *http.Request) *player.Session {
+	s, _ := request.Context().Value(sessionContextKey).(*player.Session)
+	return s
+}
+
+// currentPlayerID returns request session player ID, 0 if none.
+func currentPlayerID(request *http.Request) uint {
+	if p := currentPlayer(request); p != nil {
+		return p.ID
+	}
+	return 0
+}
+
+// setSessionCookie sets signed session cookie value in response.
+func setSessionCookie(writer http.ResponseWriter, value string) {
+	http.SetCookie(writer, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// clearSessionCookie removes session cookie from client.
+func clearSessionCookie(writer http.ResponseWriter) {
+	http.SetCookie(writer, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// JSON guess request format
+type GuessRequest struct {
+	Name string `json:"name"` // Character name, or wiki title of an ambiguous name candidate
+}
+
+// JSON connections group submission request format
+type ConnectionsRequest struct {
+	IDs []uint `json:"ids"`
+}
+
+// JSON higher or lower answer request format
+type HigherLowerRequest struct {
+	Choice string `json:"choice"` // "a" or "b"
+}
+
+// JSON challenge creation request format
+type ChallengeRequest struct {
+	CharacterID int `json:"character_id"`
+}
+
+// JSON account register or login request format
+type CredentialsRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// JSON transfer code redeem request format
+type TransferRequest struct {
+	Code string `json:"code"`
+}
+
+// JSON player profile request format
+type ProfileRequest struct {
+	DisplayName string `json:"display_name"`
+	Hidden      bool   `json:"hidden"` // Opted out of leaderboards
+}
+
+// JSON league creation or rename request format
+type LeagueRequest struct {
+	Name string `json:"name"`
+}
+
+// JSON league join request format
+type JoinLeagueRequest struct {
+	Code string `json:"code"`
+}
+
+// JSON player data deletion request format
+type DeleteRequest struct {
+	Confirm bool `json:"confirm"` // Must be true, deletion can not be undone
+}
+
+// /----- DECODE REQUEST METHODS -----/
+
+// decodeJSONRequest decodes request body in JSON format into value.
+func decodeJSONRequest(request *http.Request, value any) error {
+	defer request.Body.Close()
+	return json.NewDecoder(request.Body).Decode(value)
+}
+
+// clientIP returns request client IP address, without port.
+func clientIP(request *http.Request) string {
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+
+	return host
+}
+
+// Current API version routes prefix, unversioned "/api" routes are deprecated aliases
+const APIVersionPrefix = "/api/v1"
+
+// VersionedPath returns the current API version path of an unversioned API path, or path itself.
+func VersionedPath(path string) string {
+
+	rest, found := strings.CutPrefix(path, "/api/")
+	if !found || rest == "v1" || strings.HasPrefix(rest, "v1/") {
+		return path
+	}
+
+	return APIVersionPrefix + "/" + rest
+}
@@ -5,14 +5,16 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/doruo/falloutdle/internal/character"
 	"github.com/doruo/falloutdle/pkg/time"
 )
 
 // JSON response handler format
 type Response struct {
-	Success bool   `json:"success"`
-	Data    []any  `json:"data,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Success bool      `json:"success"`
+	Data    []any     `json:"data,omitempty"`
+	Error   string    `json:"error,omitempty"`
+	Code    ErrorCode `json:"code,omitempty"` // Machine-readable error
 }
 
 // /----- SEND RESPONSE METHODS -----/
@@ -29,14 +31,32 @@ func sendHTMLResponse(writer http.ResponseWriter, content []byte) {
 	writer.Write(content)
 }
 
-// sendErrorResponse sends response error with message and httpStatus in json format.
-func sendErrorResponse(writer http.ResponseWriter, message string, httpStatus int) {
+// sendErrorResponse sends response error with its code, message and httpStatus in json format.
+func sendErrorResponse(writer http.ResponseWriter, code ErrorCode, message string, httpStatus int) {
 
-	fmt.Println(time.Today(), "API - HTTP error", httpStatus, ":", message)
-	sendJSONResponse(writer, Response{
+	fmt.Println(time.Today(), "API - HTTP error", httpStatus, code, ":", message)
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(httpStatus)
+	sendReponse(writer, Response{
 		Success: false,
 		Data:    nil,
 		Error:   message,
+		Code:    code,
+	})
+}
+
+// sendCandidatesResponse sends an ambiguous guessed name error, with "did you mean" characters as multiple choices,
+// each one guessed again from its wiki title.
+func sendCandidatesResponse(writer http.ResponseWriter, candidates []character.SearchResult) {
+
+	fmt.Println(time.Today(), "API - ambiguous guess:", len(candidates), "candidates")
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusMultipleChoices)
+	sendReponse(writer, Response{
+		Success: false,
+		Data:    []any{candidates},
+		Error:   "Ambiguous character name, did you mean one of them?",
+		Code:    CodeAmbiguousName,
 	})
 }
 
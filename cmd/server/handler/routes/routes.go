@@ -7,10 +7,60 @@ import (
 )
 
 func SetupRoutes(mux *http.ServeMux) {
+	session := handler.NewSessionMiddleware()
 	handler := handler.NewGameHandler()
 
 	mux.HandleFunc("/", handler.HandleGetHome)
-	mux.HandleFunc("/api/today", handler.HandleGetTodayCharacter)
-	mux.HandleFunc("/api/random", handler.HandleGetRandomCharacter)
-	mux.HandleFunc("/api/guess", handler.HandlePostGuessCharacter)
+	mux.HandleFunc("/graphql", session.WithSession(handler.HandleGraphQL))
+	handleAPI(mux, "/openapi.json", handler.HandleGetOpenAPI)
+	handleAPI(mux, "/random", session.WithSession(handler.HandleGetRandomCharacter))
+	handleAPI(mux, "/characters", handler.HandleGetCharacters)
+	handleAPI(mux, "/characters/search", handler.HandleGetSearchCharacters)
+	handleAPI(mux, "/characters/{id}", handler.HandleGetCharacter)
+	handleAPI(mux, "/characters/by-title/{wikiTitle...}", handler.HandleGetCharacterByTitle)
+	handleAPI(mux, "/compare", handler.HandleGetCompare)
+	handleAPI(mux, "/guess", session.WithPlayer(handler.HandlePostGuessCharacter))
+	handleAPI(mux, "/guesses", session.WithSession(handler.HandleGetGuesses))
+	handleAPI(mux, "/actor", session.WithSession(handler.HandleGetActorClue))
+	handleAPI(mux, "/actor/guess", session.WithPlayer(handler.HandlePostGuessActor))
+	handleAPI(mux, "/connections", session.WithSession(handler.HandleGetConnections))
+	handleAPI(mux, "/connections/verify", session.WithPlayer(handler.HandlePostVerifyConnections))
+	handleAPI(mux, "/spelling", session.WithSession(handler.HandleGetSpelling))
+	handleAPI(mux, "/spelling/guess", session.WithPlayer(handler.HandlePostGuessSpelling))
+	handleAPI(mux, "/higherlower", session.WithPlayer(handler.HandleGetHigherLower))
+	handleAPI(mux, "/higherlower/answer", session.WithPlayer(handler.HandlePostAnswerHigherLower))
+	handleAPI(mux, "/challenges", session.WithPlayer(handler.HandlePostCreateChallenge))
+	handleAPI(mux, "/challenges/{token}", session.WithSession(handler.HandleGetChallenge))
+	handleAPI(mux, "/challenges/{token}/guess", session.WithPlayer(handler.HandlePostGuessChallenge))
+	handleAPI(mux, "/challenges/{token}/stats", session.WithSession(handler.HandleGetChallengeStats))
+	handleAPI(mux, "/me", session.WithSession(handler.HandleGetMe))
+	handleAPI(mux, "/me/stats", session.WithSession(handler.HandleGetMyStats))
+	handleAPI(mux, "/me/achievements", session.WithSession(handler.HandleGetMyAchievements))
+	handleAPI(mux, "/me/profile", session.WithPlayer(handler.HandlePostProfile))
+	handleAPI(mux, "/me/export", session.WithSession(handler.HandleGetMyExport))
+	handleAPI(mux, "/me/delete", session.WithSession(handler.HandlePostDeleteMe))
+	handleAPI(mux, "/me/leagues", session.WithSession(handler.HandleGetMyLeagues))
+	handleAPI(mux, "/share", session.WithSession(handler.HandleGetShare))
+	handleAPI(mux, "/cards/{file}", handler.HandleGetCard)
+	handleAPI(mux, "/leaderboard", session.WithSession(handler.HandleGetLeaderboard))
+	handleAPI(mux, "/leagues", session.WithPlayer(handler.HandlePostCreateLeague))
+	handleAPI(mux, "/leagues/join", session.WithPlayer(handler.HandlePostJoinLeague))
+	handleAPI(mux, "/leagues/{id}", session.WithSession(handler.HandleGetLeague))
+	handleAPI(mux, "/leagues/{id}/leaderboard", session.WithSession(handler.HandleGetLeagueLeaderboard))
+	handleAPI(mux, "/leagues/{id}/standings", session.WithSession(handler.HandleGetLeagueStandings))
+	handleAPI(mux, "/leagues/{id}/rename", session.WithSession(handler.HandlePostRenameLeague))
+	handleAPI(mux, "/leagues/{id}/leave", session.WithSession(handler.HandlePostLeaveLeague))
+	handleAPI(mux, "/leagues/{id}/members/{member}", session.WithSession(handler.HandleDeleteLeagueMember))
+	handleAPI(mux, "/auth/register", session.WithPlayer(handler.HandlePostRegister))
+	handleAPI(mux, "/auth/login", session.WithSession(handler.HandlePostLogin))
+	handleAPI(mux, "/auth/logout", session.WithSession(handler.HandlePostLogout))
+	handleAPI(mux, "/transfer", session.WithPlayer(handler.HandlePostCreateTransfer))
+	handleAPI(mux, "/transfer/redeem", session.WithSession(handler.HandlePostRedeemTransfer))
+}
+
+// handleAPI registers an API route pattern under current API version,
+// and unversioned under "/api" as a deprecated alias.
+func handleAPI(mux *http.ServeMux, pattern string, handle http.HandlerFunc) {
+	mux.HandleFunc(handler.APIVersionPrefix+pattern, handle)
+	mux.HandleFunc("/api"+pattern, handler.WithDeprecation(handle))
 }
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetShare returns session player finished today puzzle as spoiler-free emoji text (?mode=classic).
+func (handler *GameHandler) HandleGetShare(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: share result")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mode := game.Mode(request.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = game.ClassicMode
+	}
+
+	share, err := handler.gameService.GetShare(currentPlayerID(request), mode)
+
+	switch {
+	case errors.Is(err, game.ErrInvalidMode):
+		sendErrorResponse(writer, CodeInvalidMode, "Invalid game mode", http.StatusBadRequest)
+		return
+	case errors.Is(err, game.ErrPuzzleNotFinished):
+		sendErrorResponse(writer, CodePuzzleNotFinished, "Puzzle not finished yet", http.StatusConflict)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while sharing result", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{share},
+	})
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP GET -----/
+
+// HandleGetSpelling returns today spelling puzzle with player progress.
+func (handler *GameHandler) HandleGetSpelling(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling GET request: spelling puzzle")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodGet) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	view, err := handler.gameService.GetSpelling(currentPlayerID(request))
+
+	if err != nil {
+		sendErrorResponse(writer, CodeInternalError, "Error while getting spelling puzzle", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{view},
+	})
+}
+
+// /----- HTTP POST -----/
+
+// HandlePostGuessSpelling compares guessed name letters with today spelling character.
+func (handler *GameHandler) HandlePostGuessSpelling(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: guess spelling")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var guess GuessRequest
+	if err := decodeJSONRequest(request, &guess); err != nil {
+		sendErrorResponse(writer, CodeInvalidRequest, "Invalid guess request", http.StatusBadRequest)
+		return
+	}
+
+	result, err := handler.gameService.ProcessSpelling(currentPlayerID(request), guess.Name)
+
+	switch {
+	case errors.Is(err, game.ErrInvalidGuess), errors.Is(err, character.ErrInvalidName):
+		sendErrorResponse(writer, CodeInvalidRequest, "Guess name cannot be empty", http.StatusBadRequest)
+		return
+	case errors.Is(err, game.ErrPuzzleFinished):
+		sendErrorResponse(writer, CodePuzzleFinished, "Spelling puzzle already finished", http.StatusConflict)
+		return
+	case errors.Is(err, character.ErrCharacterNotFound):
+		sendErrorResponse(writer, CodeCharacterNotFound, "Unknown character name", http.StatusBadRequest)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while processing spelling guess", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{result},
+	})
+}
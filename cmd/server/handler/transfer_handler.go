@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/doruo/falloutdle/internal/account"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/pkg/time"
+)
+
+// /----- HTTP POST -----/
+
+// HandlePostCreateTransfer returns a short single-use code to move session player to another device.
+func (handler *GameHandler) HandlePostCreateTransfer(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: create transfer code")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	code, err := handler.accountService.CreateTransferCode(currentPlayer(request), clientIP(request))
+
+	switch {
+	case errors.Is(err, player.ErrAlreadyRegistered):
+		sendErrorResponse(writer, CodeAlreadyRegistered, "Registered players log in on other devices", http.StatusConflict)
+		return
+	case errors.Is(err, account.ErrTooManyAttempts):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusTooManyRequests)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while creating transfer code", http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{code},
+	})
+}
+
+// HandlePostRedeemTransfer moves transfer code player, with his stats and progress, to this device.
+func (handler *GameHandler) HandlePostRedeemTransfer(writer http.ResponseWriter, request *http.Request) {
+
+	fmt.Println(time.Today(), "API - handling POST request: redeem transfer code")
+
+	// Verify correct http method
+	if !isMethod(request.Method, http.MethodPost) {
+		sendErrorResponse(writer, CodeMethodNotAllowed, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var transferRequest TransferRequest
+	if err := decodeJSONRequest(request, &transferRequest); err != nil {
+		sendErrorResponse(writer, CodeInvalidJSON, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	p, session, err := handler.accountService.RedeemTransferCode(
+		currentPlayer(request), currentSession(request), transferRequest.Code, clientIP(request),
+	)
+
+	switch {
+	case errors.Is(err, player.ErrInvalidTransfer):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, account.ErrTooManyAttempts):
+		sendErrorResponse(writer, errorCode(err), err.Error(), http.StatusTooManyRequests)
+		return
+	case err != nil:
+		sendErrorResponse(writer, CodeInternalError, "Error while redeeming transfer code", http.StatusInternalServerError)
+		return
+	}
+
+	setSessionCookie(writer, handler.accountService.Cookie(session))
+	sendJSONResponse(writer, Response{
+		Success: true,
+		Data:    []any{p},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/doruo/falloutdle/cmd/server/handler"
 	"github.com/doruo/falloutdle/cmd/server/handler/routes"
 )
 
@@ -13,8 +14,11 @@ func main() {
 	mux := http.NewServeMux()
 	routes.SetupRoutes(mux)
 
+	// Requests are validated against the OpenAPI document before reaching routes
+	server := handler.NewValidationMiddleware().WithValidation(mux)
+
 	host := os.Getenv("HOST")
 	port := ":" + os.Getenv("PORT")
 	log.Print("Server listening on http://", host, port)
-	log.Fatal(http.ListenAndServe(port, mux))
+	log.Fatal(http.ListenAndServe(port, server))
 }
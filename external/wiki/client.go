@@ -42,17 +42,10 @@ func NewWikiClient() *WikiClient {
 // FetchCharacterByName retrieves the raw content of a wiki page and returns a character struct
 func (w *WikiClient) FetchCharacterByName(name string) (*character.Character, error) {
 
-	content, err := w.FetchPageContent(name)
+	c, err := w.FetchCharacterPage(name)
 
 	if err != nil {
-		log.Printf("Error while fetching page content for %s: %v", name, err)
-		return nil, err
-	}
-
-	c, err := w.ParseCharacterFromContent(name, content)
-
-	if err != nil {
-		log.Printf("Error while parsing character %s: %v", name, err)
+		log.Printf("Error while fetching character %s: %v", name, err)
 		return nil, err
 	}
 
@@ -134,15 +127,9 @@ func (w *WikiClient) FetchCharactersByGame(game character.GameCode) ([]*characte
 				continue
 			}
 
-			content, err := w.FetchPageContent(member.Title)
+			character, err := w.FetchCharacterPage(member.Title)
 			if err != nil {
-				log.Printf("Failed to get content for %s: %v", member.Title, err)
-				continue
-			}
-
-			character, err := w.ParseCharacterFromContent(member.Title, content)
-			if err != nil {
-				log.Printf("Failed to parse character %s: %v", member.Title, err)
+				log.Printf("Failed to get character %s: %v", member.Title, err)
 				continue
 			}
 
@@ -161,11 +148,24 @@ func (w *WikiClient) FetchCharactersByGame(game character.GameCode) ([]*characte
 // FetchPageContent retrieves the raw content of a wiki page
 func (w *WikiClient) FetchPageContent(title string) (string, error) {
 
+	page, err := w.FetchPage(title)
+	if err != nil {
+		return "", err
+	}
+
+	return page.Content(), nil
+}
+
+// FetchPage retrieves a wiki page with its raw content and the pages redirecting to it
+func (w *WikiClient) FetchPage(title string) (*WikiPage, error) {
+
 	params := url.Values{}
 	params.Add("action", "query")
-	params.Add("prop", "revisions")
+	params.Add("prop", "revisions|redirects")
 	params.Add("rvprop", "content")
 	params.Add("rvslots", "main")
+	params.Add("rdnamespace", "0")
+	params.Add("rdlimit", "max")
 	params.Add("format", "json")
 	params.Add("titles", title)
 
@@ -175,14 +175,14 @@ func (w *WikiClient) FetchPageContent(title string) (string, error) {
 	resp, err := w.httpClient.Get(fullURL)
 
 	if err != nil {
-		return "", fmt.Errorf("http request failed: %w", err)
+		return nil, fmt.Errorf("http request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	var wikiResp WikiResponse
 
 	if err := json.NewDecoder(resp.Body).Decode(&wikiResp); err != nil {
-		return "", fmt.Errorf("json decode failed: %w", err)
+		return nil, fmt.Errorf("json decode failed: %w", err)
 	}
 
 	// Extract content from response first page
@@ -190,17 +190,38 @@ func (w *WikiClient) FetchPageContent(title string) (string, error) {
 
 		// Error case
 		if page.PageID == -1 {
-			return "", fmt.Errorf("page not found: %s", title)
+			return nil, fmt.Errorf("page not found: %s", title)
 		}
 
 		// Content found
 		if len(page.Revisions) > 0 {
-			return page.Revisions[0].Slots.Main.Content, nil
+			return &page, nil
 		}
 	}
 
 	// Content not found
-	return "", fmt.Errorf("no content found for page: %s", title)
+	return nil, fmt.Errorf("no content found for page: %s", title)
+}
+
+// FetchCharacterPage retrieves a wiki page and returns a character struct,
+// known by the pages titles redirecting to it
+func (w *WikiClient) FetchCharacterPage(title string) (*character.Character, error) {
+
+	page, err := w.FetchPage(title)
+	if err != nil {
+		return nil, err
+	}
+
+	char, err := w.ParseCharacterFromContent(title, page.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, redirect := range page.Redirects {
+		char.AddAlias(redirect.Title, character.RedirectAlias)
+	}
+
+	return char, nil
 }
 
 // /--- PARSE FUNCTIONS ---/
@@ -270,11 +291,18 @@ func (w *WikiClient) ParseCharacterFromContent(title, content string) (*characte
 			char.Role = w.cleanWikiText(value)
 		case "titles":
 			char.Titles = w.parseTitles(value)
+		case "actor", "voice actor":
+			char.Actors = w.parseActors(value)
 		case "image":
 			char.ImageURL = w.parseImageURL(value)
 		}
 	}
 
+	// Titles are names character is also known by
+	for _, title := range char.Titles {
+		char.AddAlias(title, character.TitleAlias)
+	}
+
 	// Set main game
 	char.MainGame = char.GetMainGame()
 	return char, nil
@@ -373,6 +401,29 @@ func (w *WikiClient) parseTitles(value string) []string {
 	return titles
 }
 
+// parseActors parses actor field which can hold several voice actors
+func (w *WikiClient) parseActors(value string) []string {
+
+	// Actors are separated by <br> tags, list items or commas
+	breakRegex := regexp.MustCompile(`(?i)<br\s*/?>|\n|,`)
+	// Remove notes like (Fallout 4) or (uncredited)
+	noteRegex := regexp.MustCompile(`\([^)]*\)`)
+
+	var actors []string
+	for _, part := range breakRegex.Split(value, -1) {
+
+		part = strings.TrimPrefix(strings.TrimSpace(part), "*")
+		clean := w.cleanWikiText(part)
+		clean = strings.TrimSpace(noteRegex.ReplaceAllString(clean, ""))
+
+		if clean != "" {
+			actors = append(actors, clean)
+		}
+	}
+
+	return actors
+}
+
 // parseImageURL extracts image filename (could be expanded to full URL)
 func (w *WikiClient) parseImageURL(value string) string {
 	// For now, just return the filename
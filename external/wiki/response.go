@@ -12,6 +12,21 @@ type WikiPage struct {
 	PageID    int            `json:"pageid"`
 	Title     string         `json:"title"`
 	Revisions []WikiRevision `json:"revisions"`
+	Redirects []WikiRedirect `json:"redirects"` // Pages redirecting to this page
+}
+
+// Content returns page last revision raw content
+func (p *WikiPage) Content() string {
+	if len(p.Revisions) == 0 {
+		return ""
+	}
+	return p.Revisions[0].Slots.Main.Content
+}
+
+// WikiRedirect represents a page redirecting to another page
+type WikiRedirect struct {
+	PageID int    `json:"pageid"`
+	Title  string `json:"title"`
 }
 
 // WikiRevision represents a page revision
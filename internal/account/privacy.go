@@ -0,0 +1,118 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/achievement"
+	"github.com/doruo/falloutdle/internal/challenge"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/internal/score"
+	"github.com/doruo/falloutdle/internal/stats"
+	"gorm.io/gorm"
+)
+
+// Export represents everything stored about a player
+type Export struct {
+	ExportedAt      time.Time             `json:"exported_at"`
+	Player          *player.Player        `json:"player"`
+	Sessions        []player.Session      `json:"sessions"`
+	Progress        []player.Progress     `json:"progress"`
+	Results         []stats.Result        `json:"results"`
+	Stats           []stats.Stat          `json:"stats"`
+	Scores          []score.Score         `json:"scores"`
+	Challenges      []challenge.Challenge `json:"challenges"`
+	ChallengeSolves []challenge.Solve     `json:"challenge_solves"`
+	Leagues         []league.Membership   `json:"leagues"`
+	Achievements    []achievement.Unlock  `json:"achievements"`
+}
+
+// /----- PRIVACY FUNCTIONS -----/
+
+// Export returns everything stored about player, read in a single transaction
+func (s *Service) Export(p *player.Player) (*Export, error) {
+
+	export := &Export{ExportedAt: time.Now().UTC(), Player: p}
+
+	err := s.db.Transaction(func(tx *gorm.DB) (err error) {
+
+		players := player.NewPlayerRepository(tx)
+		if export.Sessions, err = players.GetSessions(p.ID); err != nil {
+			return fmt.Errorf("failed to export sessions: %w", err)
+		}
+		if export.Progress, err = players.GetAllProgress(p.ID); err != nil {
+			return fmt.Errorf("failed to export progress: %w", err)
+		}
+
+		statsRepo := stats.NewStatsRepository(tx)
+		if export.Results, err = statsRepo.GetResults(p.ID); err != nil {
+			return fmt.Errorf("failed to export results: %w", err)
+		}
+		if export.Stats, err = statsRepo.GetStats(p.ID); err != nil {
+			return fmt.Errorf("failed to export stats: %w", err)
+		}
+
+		if export.Scores, err = score.NewScoreRepository(tx).GetAllByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to export scores: %w", err)
+		}
+
+		challenges := challenge.NewChallengeRepository(tx)
+		if export.Challenges, err = challenges.GetByCreator(p.ID); err != nil {
+			return fmt.Errorf("failed to export challenges: %w", err)
+		}
+		if export.ChallengeSolves, err = challenges.GetSolvesByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to export challenge solves: %w", err)
+		}
+
+		if export.Leagues, err = league.NewLeagueService(league.NewLeagueRepository(tx)).GetMemberships(p.ID); err != nil {
+			return fmt.Errorf("failed to export leagues: %w", err)
+		}
+
+		if export.Achievements, err = achievement.NewAchievementRepository(tx).GetUnlocks(p.ID); err != nil {
+			return fmt.Errorf("failed to export achievements: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// Delete deletes everything stored about player in a single transaction,
+// nothing is deleted if any step fails.
+// Leagues he was the last member of are deleted, other players data is kept.
+func (s *Service) Delete(p *player.Player) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := league.NewLeagueService(league.NewLeagueRepository(tx)).LeaveAll(p.ID); err != nil {
+			return fmt.Errorf("failed to delete leagues memberships: %w", err)
+		}
+
+		if err := achievement.NewAchievementRepository(tx).DeleteByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to delete achievements: %w", err)
+		}
+
+		if err := challenge.NewChallengeRepository(tx).DeleteByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to delete challenges: %w", err)
+		}
+
+		if err := score.NewScoreRepository(tx).DeleteByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to delete scores: %w", err)
+		}
+
+		if err := stats.NewStatsRepository(tx).DeleteByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to delete results: %w", err)
+		}
+
+		if err := player.NewPlayerRepository(tx).DeleteByPlayer(p.ID); err != nil {
+			return fmt.Errorf("failed to delete player: %w", err)
+		}
+
+		return nil
+	})
+}
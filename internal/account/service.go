@@ -0,0 +1,136 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/achievement"
+	"github.com/doruo/falloutdle/internal/challenge"
+	"github.com/doruo/falloutdle/internal/database"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/internal/score"
+	"github.com/doruo/falloutdle/internal/stats"
+	"github.com/doruo/falloutdle/pkg/ratelimit"
+	"gorm.io/gorm"
+)
+
+// Login attempts per client, against passwords guessing
+var loginLimiter = ratelimit.NewLimiter(10, 10*time.Minute)
+
+// Service handles registered accounts: registration, login with anonymous history merge and logout
+type Service struct {
+	db            *gorm.DB
+	playerService *player.Service
+}
+
+var instance *Service
+
+// NewAccountService creates a new account service
+func NewAccountService(db *gorm.DB, playerService *player.Service) *Service {
+	return &Service{db: db, playerService: playerService}
+}
+
+func GetServiceInstance() *Service {
+	if instance == nil {
+		db := database.GetInstance()
+		repo := player.NewPlayerRepository(db)
+		instance = NewAccountService(db, player.NewPlayerService(repo, player.NewDefaultCookieSigner()))
+	}
+	return instance
+}
+
+// /----- ACCOUNT FUNCTIONS -----/
+
+// Register turns session anonymous player into an account, and returns his new session
+func (s *Service) Register(p *player.Player, session *player.Session, username string, password string) (*player.Session, error) {
+
+	if err := s.playerService.Register(p, username, password); err != nil {
+		return nil, err
+	}
+
+	return s.playerService.RotateSession(session, p.ID)
+}
+
+// Login authenticates account and returns it with a new session.
+// Session anonymous player history is merged into the account.
+func (s *Service) Login(p *player.Player, session *player.Session, username string, password string, client string) (*player.Player, *player.Session, error) {
+
+	if !loginLimiter.Allow(client) {
+		return nil, nil, ErrTooManyAttempts
+	}
+
+	account, err := s.playerService.Authenticate(username, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if p != nil && !p.IsRegistered() && p.ID != account.ID {
+		if err := s.merge(p.ID, account.ID); err != nil {
+			return nil, nil, fmt.Errorf("failed to merge player: %w", err)
+		}
+	}
+
+	session, err = s.playerService.RotateSession(session, account.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, session, nil
+}
+
+// UpdateProfile sets player display name and leaderboards opt-out
+func (s *Service) UpdateProfile(p *player.Player, displayName string, hidden bool) error {
+	return s.playerService.UpdateProfile(p, displayName, hidden)
+}
+
+// Cookie returns session signed cookie value
+func (s *Service) Cookie(session *player.Session) string {
+	return s.playerService.Cookie(session)
+}
+
+// Logout ends session, a new anonymous player is created on next saved play
+func (s *Service) Logout(session *player.Session) error {
+	return s.playerService.EndSession(session)
+}
+
+// /----- MERGE FUNCTIONS -----/
+
+// merge moves all player from history to player to, then deletes player from.
+// Done in a single transaction, so history is never half moved.
+func (s *Service) merge(from uint, to uint) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+
+		players := player.NewPlayerRepository(tx)
+		if err := players.MergePlayer(from, to); err != nil {
+			return fmt.Errorf("failed to merge progress: %w", err)
+		}
+
+		if err := score.NewScoreRepository(tx).MergePlayer(from, to); err != nil {
+			return fmt.Errorf("failed to merge scores: %w", err)
+		}
+
+		if err := challenge.NewChallengeRepository(tx).MergePlayer(from, to); err != nil {
+			return fmt.Errorf("failed to merge challenges: %w", err)
+		}
+
+		if err := league.NewLeagueRepository(tx).MergePlayer(from, to); err != nil {
+			return fmt.Errorf("failed to merge leagues: %w", err)
+		}
+
+		if err := achievement.NewAchievementRepository(tx).MergePlayer(from, to); err != nil {
+			return fmt.Errorf("failed to merge achievements: %w", err)
+		}
+
+		statsRepo := stats.NewStatsRepository(tx)
+		if err := statsRepo.MergePlayer(from, to); err != nil {
+			return fmt.Errorf("failed to merge results: %w", err)
+		}
+
+		if err := stats.NewStatsService(statsRepo).Rebuild(to); err != nil {
+			return err
+		}
+
+		return players.DeleteByID(from)
+	})
+}
@@ -0,0 +1,81 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/pkg/ratelimit"
+)
+
+var ErrTooManyAttempts = errors.New("too many attempts, try again later")
+
+var (
+	// Transfer codes created per client
+	transferCreateLimiter = ratelimit.NewLimiter(5, time.Hour)
+
+	// Redeem attempts per client, against codes guessing
+	transferRedeemLimiter = ratelimit.NewLimiter(5, 10*time.Minute)
+)
+
+// CreateTransferCode creates a single-use code moving anonymous player to another device.
+// Registered players log in on other devices instead.
+func (s *Service) CreateTransferCode(p *player.Player, client string) (*player.TransferCode, error) {
+
+	if p.IsRegistered() {
+		return nil, player.ErrAlreadyRegistered
+	}
+
+	if !transferCreateLimiter.Allow(client) {
+		return nil, ErrTooManyAttempts
+	}
+
+	return s.playerService.NewTransferCode(p.ID)
+}
+
+// RedeemTransferCode moves code player to session device and returns him with the device session.
+// An anonymous session player history is merged into the code player, taking over the device,
+// while a registered one keeps the device and gets the code player history. A device without player
+// simply takes over the code player.
+func (s *Service) RedeemTransferCode(p *player.Player, session *player.Session, code string, client string) (*player.Player, *player.Session, error) {
+
+	if !transferRedeemLimiter.Allow(client) {
+		return nil, nil, ErrTooManyAttempts
+	}
+
+	transferred, err := s.playerService.RedeemTransferCode(code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if p == nil {
+		session, err = s.playerService.RotateSession(session, transferred.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return transferred, session, nil
+	}
+
+	if p.ID == transferred.ID {
+		return p, session, nil
+	}
+
+	if p.IsRegistered() {
+		if err := s.merge(transferred.ID, p.ID); err != nil {
+			return nil, nil, fmt.Errorf("failed to merge player: %w", err)
+		}
+		return p, session, nil
+	}
+
+	if err := s.merge(p.ID, transferred.ID); err != nil {
+		return nil, nil, fmt.Errorf("failed to merge player: %w", err)
+	}
+
+	session, err = s.playerService.RotateSession(session, transferred.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transferred, session, nil
+}
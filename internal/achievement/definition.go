@@ -0,0 +1,103 @@
+package achievement
+
+import "github.com/doruo/falloutdle/internal/character"
+
+// Metric represents what an achievement counts in player history
+type Metric string
+
+const (
+	WinsMetric       Metric = "wins"       // Won puzzles matching criteria
+	StreakMetric     Metric = "streak"     // Best daily streak
+	CharactersMetric Metric = "characters" // Distinct solved characters matching criteria
+)
+
+// Criteria represents which player results an achievement counts.
+// Zero values match everything.
+type Criteria struct {
+	Metric     Metric
+	Mode       string // Results of this game mode
+	MaxGuesses int    // Wins in at most this many guesses
+	Race       string // Answers of this race
+	Game       string // Answers appearing in this game
+}
+
+// Definition represents an achievement, unlocked once its criteria count reaches target
+type Definition struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Criteria    Criteria `json:"-"`
+	Target      int      `json:"-"` // 0 for every character matching criteria
+}
+
+// Definitions is the full list of achievements, IDs are stored and must never change
+var Definitions = []Definition{
+	{
+		ID:          "first_win",
+		Name:        "Welcome to the Wasteland",
+		Description: "Solve your first puzzle",
+		Criteria:    Criteria{Metric: WinsMetric},
+		Target:      1,
+	},
+	{
+		ID:          "solved_in_one",
+		Name:        "One Shot, One Kill",
+		Description: "Solve a puzzle in a single guess",
+		Criteria:    Criteria{Metric: WinsMetric, MaxGuesses: 1},
+		Target:      1,
+	},
+	{
+		ID:          "perfect_connections",
+		Name:        "Perfect Sort",
+		Description: "Solve a connections puzzle without any mistake",
+		Criteria:    Criteria{Metric: WinsMetric, Mode: "connections", MaxGuesses: 4},
+		Target:      1,
+	},
+	{
+		ID:          "streak_7",
+		Name:        "Wasteland Survivor",
+		Description: "Reach a 7-day streak",
+		Criteria:    Criteria{Metric: StreakMetric},
+		Target:      7,
+	},
+	{
+		ID:          "streak_30",
+		Name:        "Iron Man",
+		Description: "Reach a 30-day streak",
+		Criteria:    Criteria{Metric: StreakMetric},
+		Target:      30,
+	},
+	{
+		ID:          "wins_100",
+		Name:        "Legend of the Wasteland",
+		Description: "Solve 100 puzzles",
+		Criteria:    Criteria{Metric: WinsMetric},
+		Target:      100,
+	},
+	{
+		ID:          "super_mutant_10",
+		Name:        "Mutie Hunter",
+		Description: "Guess a super mutant correctly 10 times",
+		Criteria:    Criteria{Metric: WinsMetric, Race: "Super mutant"},
+		Target:      10,
+	},
+	{
+		ID:          "ghoul_10",
+		Name:        "Necrotic Postman",
+		Description: "Guess a ghoul correctly 10 times",
+		Criteria:    Criteria{Metric: WinsMetric, Race: "Ghoul"},
+		Target:      10,
+	},
+	{
+		ID:          "every_fo1",
+		Name:        "Vault 13 Archivist",
+		Description: "Solve every Fallout character",
+		Criteria:    Criteria{Metric: CharactersMetric, Game: string(character.FO1)},
+	},
+	{
+		ID:          "every_fnv",
+		Name:        "Courier's Memory",
+		Description: "Solve every Fallout: New Vegas character",
+		Criteria:    Criteria{Metric: CharactersMetric, Game: string(character.FNV)},
+	},
+}
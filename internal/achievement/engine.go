@@ -0,0 +1,99 @@
+package achievement
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+// Evaluate returns player current count towards achievement and its target, from his history.
+// Current count never exceeds target.
+func (d *Definition) Evaluate(facts *Facts) (int, int) {
+
+	criteria := &d.Criteria
+	target := d.Target
+	current := 0
+
+	switch criteria.Metric {
+
+	case WinsMetric:
+		for i := range facts.Results {
+			if criteria.matchesResult(&facts.Results[i], facts) {
+				current++
+			}
+		}
+
+	case StreakMetric:
+		for _, stat := range facts.Stats {
+			if criteria.Mode == "" || stat.Mode == criteria.Mode {
+				current = max(current, stat.MaxStreak)
+			}
+		}
+
+	case CharactersMetric:
+		solved := make(map[uint]bool)
+		for i := range facts.Results {
+			if result := &facts.Results[i]; criteria.matchesResult(result, facts) {
+				solved[result.CharacterID] = true
+			}
+		}
+		current = len(solved)
+
+		// Every matching character
+		if target == 0 {
+			for _, c := range facts.Characters {
+				if criteria.matchesCharacter(c) {
+					target++
+				}
+			}
+		}
+	}
+
+	return min(current, target), target
+}
+
+// IsUnlocked determines if player history reaches achievement target
+func (d *Definition) IsUnlocked(facts *Facts) bool {
+	current, target := d.Evaluate(facts)
+	return target > 0 && current >= target
+}
+
+// matchesResult determines if result is a win counted by criteria.
+// Practice plays are never counted, as stats do not count them either.
+func (c *Criteria) matchesResult(result *stats.Result, facts *Facts) bool {
+
+	if !result.Won || result.Practice {
+		return false
+	}
+
+	if c.Mode != "" && result.Mode != c.Mode {
+		return false
+	}
+
+	if c.MaxGuesses > 0 && result.Guesses > c.MaxGuesses {
+		return false
+	}
+
+	if c.Race == "" && c.Game == "" && c.Metric != CharactersMetric {
+		return true
+	}
+
+	answer, exists := facts.Characters[result.CharacterID]
+	return exists && c.matchesCharacter(answer)
+}
+
+// matchesCharacter determines if character has criteria race and game
+func (c *Criteria) matchesCharacter(answer *character.Character) bool {
+
+	if c.Race != "" && !strings.Contains(strings.ToLower(answer.Race), strings.ToLower(c.Race)) {
+		return false
+	}
+
+	if c.Game != "" && !slices.Contains(answer.Games, c.Game) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,65 @@
+package achievement
+
+import (
+	"time"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+// Unlock represents an achievement unlocked by a player
+type Unlock struct {
+	ID            uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	PlayerID      uint      `json:"-" gorm:"uniqueIndex:idx_unlock_player_achievement"`
+	AchievementID string    `json:"achievement_id" gorm:"size:50;uniqueIndex:idx_unlock_player_achievement"`
+	UnlockedAt    time.Time `json:"unlocked_at" gorm:"autoCreateTime"`
+}
+
+// Facts represents a player history achievements are evaluated on
+type Facts struct {
+	Results    []stats.Result
+	Stats      []stats.StatView
+	Characters map[uint]*character.Character // Puzzle answers by ID
+}
+
+// NewFacts creates new facts from player results and stats, with characters as possible answers.
+// Characters must include played ones, as every past daily answer has been played,
+// incomplete ones are left out as they are never picked as daily answers.
+func NewFacts(results []stats.Result, statViews []stats.StatView, characters []character.Character) *Facts {
+
+	facts := &Facts{
+		Results:    results,
+		Stats:      statViews,
+		Characters: make(map[uint]*character.Character, len(characters)),
+	}
+
+	for i := range characters {
+		if characters[i].IsComplete() {
+			facts.Characters[characters[i].ID] = &characters[i]
+		}
+	}
+
+	return facts
+}
+
+// Progress represents a player progress towards an achievement
+type Progress struct {
+	Definition
+	Current    int        `json:"current"`
+	Target     int        `json:"target"`
+	Unlocked   bool       `json:"unlocked"`
+	UnlockedAt *time.Time `json:"unlocked_at,omitempty"`
+}
+
+// NewUnlock creates a new Unlock instance
+func NewUnlock(playerID uint, achievementID string) *Unlock {
+	return &Unlock{
+		PlayerID:      playerID,
+		AchievementID: achievementID,
+	}
+}
+
+// TableName overrides default table name to give unlocks context
+func (Unlock) TableName() string {
+	return "achievement_unlocks"
+}
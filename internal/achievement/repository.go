@@ -0,0 +1,64 @@
+package achievement
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewAchievementRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// /----- CREATE -----/
+
+// AddUnlock creates a new unlock record in the database,
+// returns false if player already unlocked this achievement.
+func (r *Repository) AddUnlock(unlock *Unlock) (bool, error) {
+
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(unlock)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// /----- READ -----/
+
+// GetUnlocks retrieves a player unlocked achievements
+func (r *Repository) GetUnlocks(playerID uint) ([]Unlock, error) {
+
+	var unlocks []Unlock
+	result := r.db.Where("player_id = ?", playerID).Order("unlocked_at").Find(&unlocks)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return unlocks, nil
+}
+
+// /----- UPDATE -----/
+
+// MergePlayer moves player from unlocks to player to, whose unlock is kept on a same achievement
+func (r *Repository) MergePlayer(from uint, to uint) error {
+
+	kept := r.db.Model(&Unlock{}).Select("achievement_id").Where("player_id = ?", to)
+
+	if err := r.db.Where("player_id = ? AND achievement_id IN (?)", from, kept).Delete(&Unlock{}).Error; err != nil {
+		return err
+	}
+
+	return r.db.Model(&Unlock{}).Where("player_id = ?", from).Update("player_id", to).Error
+}
+
+// /----- DELETE -----/
+
+// DeleteByPlayer deletes a player unlocks
+func (r *Repository) DeleteByPlayer(playerID uint) error {
+	return r.db.Where("player_id = ?", playerID).Delete(&Unlock{}).Error
+}
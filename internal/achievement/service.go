@@ -0,0 +1,81 @@
+package achievement
+
+import "fmt"
+
+// Service handles players achievements unlocks and progress
+type Service struct {
+	repo *Repository
+}
+
+// NewAchievementService creates a new achievement service
+func NewAchievementService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Check unlocks achievements reached by player history, and returns the newly unlocked ones
+func (s *Service) Check(playerID uint, facts *Facts) ([]Definition, error) {
+
+	unlocked, err := s.getUnlocks(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	newly := make([]Definition, 0)
+	for _, definition := range Definitions {
+
+		if _, exists := unlocked[definition.ID]; exists || !definition.IsUnlocked(facts) {
+			continue
+		}
+
+		added, err := s.repo.AddUnlock(NewUnlock(playerID, definition.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to save unlock: %w", err)
+		}
+
+		if added {
+			newly = append(newly, definition)
+		}
+	}
+
+	return newly, nil
+}
+
+// GetProgress returns player progress towards every achievement
+func (s *Service) GetProgress(playerID uint, facts *Facts) ([]Progress, error) {
+
+	unlocked, err := s.getUnlocks(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]Progress, len(Definitions))
+	for i, definition := range Definitions {
+
+		current, target := definition.Evaluate(facts)
+		progress[i] = Progress{Definition: definition, Current: current, Target: target}
+
+		// Kept once unlocked, even if a later target grows with new characters
+		if unlock, exists := unlocked[definition.ID]; exists {
+			progress[i].Unlocked = true
+			progress[i].UnlockedAt = &unlock.UnlockedAt
+		}
+	}
+
+	return progress, nil
+}
+
+// getUnlocks returns player unlocks by achievement ID
+func (s *Service) getUnlocks(playerID uint) (map[string]Unlock, error) {
+
+	unlocks, err := s.repo.GetUnlocks(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unlocks: %w", err)
+	}
+
+	byID := make(map[string]Unlock, len(unlocks))
+	for _, unlock := range unlocks {
+		byID[unlock.AchievementID] = unlock
+	}
+
+	return byID, nil
+}
@@ -0,0 +1,38 @@
+package challenge
+
+import "time"
+
+// Challenge represents a custom puzzle on a character chosen by its creator
+type Challenge struct {
+	ID          uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	CharacterID uint      `json:"-" gorm:"index"` // Never exposed, hidden in token
+	CreatorID   uint      `json:"-" gorm:"index"`
+	Solves      int       `json:"solves"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Solve represents a player who solved a challenge, at most once
+type Solve struct {
+	ID          uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	ChallengeID uint      `json:"-" gorm:"uniqueIndex:idx_solve_challenge_player"`
+	PlayerID    uint      `json:"-" gorm:"uniqueIndex:idx_solve_challenge_player"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewChallenge creates a new Challenge instance
+func NewChallenge(characterID uint, creatorID uint) *Challenge {
+	return &Challenge{
+		CharacterID: characterID,
+		CreatorID:   creatorID,
+	}
+}
+
+// TableName overrides default table name to avoid conflicts with other solves
+func (Solve) TableName() string {
+	return "challenge_solves"
+}
+
+// IsCreator determines if player created the challenge
+func (c *Challenge) IsCreator(playerID uint) bool {
+	return playerID != 0 && c.CreatorID == playerID
+}
@@ -0,0 +1,176 @@
+package challenge
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewChallengeRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// /----- CREATE -----/
+
+// Add creates a new challenge record in the database
+func (r *Repository) Add(challenge *Challenge) error {
+
+	result := r.db.Create(challenge)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// AddSolve records a player solve and increments challenge solves count,
+// returns false if player already solved it.
+func (r *Repository) AddSolve(challenge *Challenge, playerID uint) (bool, error) {
+
+	added := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&Solve{ChallengeID: challenge.ID, PlayerID: playerID})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		added = true
+		return tx.Model(challenge).UpdateColumn("solves", gorm.Expr("solves + 1")).Error
+	})
+
+	return added, err
+}
+
+// /----- READ -----/
+
+// GetByID retrieves a challenge by its ID
+func (r *Repository) GetByID(id uint) (*Challenge, error) {
+
+	var challenge Challenge
+	result := r.db.First(&challenge, id)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrChallengeNotFound
+		}
+		return nil, result.Error
+	}
+
+	return &challenge, nil
+}
+
+// HasSolved determines if player solved challenge
+func (r *Repository) HasSolved(challengeID uint, playerID uint) (bool, error) {
+
+	var count int64
+	result := r.db.Model(&Solve{}).
+		Where("challenge_id = ? AND player_id = ?", challengeID, playerID).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// GetByCreator retrieves challenges created by a player
+func (r *Repository) GetByCreator(playerID uint) ([]Challenge, error) {
+
+	var challenges []Challenge
+	result := r.db.Where("creator_id = ?", playerID).Order("created_at").Find(&challenges)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return challenges, nil
+}
+
+// GetSolvesByPlayer retrieves challenges solved by a player
+func (r *Repository) GetSolvesByPlayer(playerID uint) ([]Solve, error) {
+
+	var solves []Solve
+	result := r.db.Where("player_id = ?", playerID).Order("created_at").Find(&solves)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return solves, nil
+}
+
+// /----- UPDATE -----/
+
+// MergePlayer moves player from challenges and solves to player to.
+// A challenge solved by both players is only counted once.
+func (r *Repository) MergePlayer(from uint, to uint) error {
+
+	if err := r.db.Model(&Challenge{}).Where("creator_id = ?", from).Update("creator_id", to).Error; err != nil {
+		return err
+	}
+
+	solved := r.db.Model(&Solve{}).Select("challenge_id").Where("player_id = ?", to)
+
+	var duplicates []Solve
+	if err := r.db.Where("player_id = ? AND challenge_id IN (?)", from, solved).Find(&duplicates).Error; err != nil {
+		return err
+	}
+
+	for _, solve := range duplicates {
+
+		if err := r.db.Delete(&solve).Error; err != nil {
+			return err
+		}
+
+		result := r.db.Model(&Challenge{}).Where("id = ?", solve.ChallengeID).
+			UpdateColumn("solves", gorm.Expr("solves - 1"))
+
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return r.db.Model(&Solve{}).Where("player_id = ?", from).Update("player_id", to).Error
+}
+
+// /----- DELETE -----/
+
+// DeleteByPlayer deletes a player solves, uncounted from their challenges,
+// and challenges he created with all their solves.
+func (r *Repository) DeleteByPlayer(playerID uint) error {
+
+	solved := r.db.Model(&Solve{}).Select("challenge_id").Where("player_id = ?", playerID)
+
+	result := r.db.Model(&Challenge{}).Where("id IN (?)", solved).
+		UpdateColumn("solves", gorm.Expr("solves - 1"))
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if err := r.db.Where("player_id = ?", playerID).Delete(&Solve{}).Error; err != nil {
+		return err
+	}
+
+	created := r.db.Model(&Challenge{}).Select("id").Where("creator_id = ?", playerID)
+
+	if err := r.db.Where("challenge_id IN (?)", created).Delete(&Solve{}).Error; err != nil {
+		return err
+	}
+
+	return r.db.Where("creator_id = ?", playerID).Delete(&Challenge{}).Error
+}
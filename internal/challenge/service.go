@@ -0,0 +1,90 @@
+package challenge
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNotCreator        = errors.New("only challenge creator can see its statistics")
+	ErrChallengeNotFound = errors.New("challenge not found")
+)
+
+// Service handles custom challenges
+type Service struct {
+	repo    *Repository
+	tokener *Tokener
+}
+
+// NewChallengeService creates a new challenge service
+func NewChallengeService(repo *Repository, tokener *Tokener) *Service {
+	return &Service{repo: repo, tokener: tokener}
+}
+
+// Create creates a new challenge on character and returns it with its token.
+func (s *Service) Create(characterID uint, creatorID uint) (*Challenge, string, error) {
+
+	if characterID == 0 {
+		return nil, "", errors.New("invalid character ID")
+	}
+
+	challenge := NewChallenge(characterID, creatorID)
+	if err := s.repo.Add(challenge); err != nil {
+		return nil, "", fmt.Errorf("failed to create challenge: %w", err)
+	}
+
+	return challenge, s.tokener.Seal(challenge.ID), nil
+}
+
+// GetByToken retrieves a challenge from its token
+func (s *Service) GetByToken(token string) (*Challenge, error) {
+
+	id, err := s.tokener.Open(token)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// GetStats retrieves a challenge from its token, only for its creator
+func (s *Service) GetStats(token string, playerID uint) (*Challenge, error) {
+
+	challenge, err := s.GetByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !challenge.IsCreator(playerID) {
+		return nil, ErrNotCreator
+	}
+
+	return challenge, nil
+}
+
+// HasSolved determines if player already solved challenge
+func (s *Service) HasSolved(challenge *Challenge, playerID uint) bool {
+
+	solved, err := s.repo.HasSolved(challenge.ID, playerID)
+	return err == nil && solved
+}
+
+// MarkSolved records player solve, counted once per player
+func (s *Service) MarkSolved(challenge *Challenge, playerID uint) error {
+
+	added, err := s.repo.AddSolve(challenge, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to record challenge solve: %w", err)
+	}
+
+	if added {
+		challenge.Solves++
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package challenge
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+
+	"github.com/doruo/falloutdle/pkg/secret"
+)
+
+var ErrInvalidToken = errors.New("invalid challenge token")
+
+// Tokener seals challenges IDs into encrypted and authenticated tokens
+type Tokener struct {
+	aead cipher.AEAD
+}
+
+// NewTokener creates a new Tokener from a 32 bytes key
+func NewTokener(key []byte) (*Tokener, error) {
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tokener{aead: aead}, nil
+}
+
+// NewDefaultTokener creates a new Tokener with key from CHALLENGE_SECRET environment variable
+func NewDefaultTokener() *Tokener {
+
+	tokener, err := NewTokener(secret.Key("CHALLENGE_SECRET"))
+	if err != nil {
+		panic(err)
+	}
+
+	return tokener
+}
+
+// Seal returns an encrypted token holding challenge ID.
+func (t *Tokener) Seal(id uint) string {
+
+	nonce := make([]byte, t.aead.NonceSize())
+	rand.Read(nonce)
+
+	plain := binary.BigEndian.AppendUint64(nil, uint64(id))
+	sealed := t.aead.Seal(nonce, nonce, plain, nil)
+
+	return base64.RawURLEncoding.EncodeToString(sealed)
+}
+
+// Open returns challenge ID from token, fails if token has been tampered with.
+func (t *Tokener) Open(token string) (uint, error) {
+
+	sealed, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(sealed) < t.aead.NonceSize() {
+		return 0, ErrInvalidToken
+	}
+
+	nonce, ciphertext := sealed[:t.aead.NonceSize()], sealed[t.aead.NonceSize():]
+
+	plain, err := t.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil || len(plain) != 8 {
+		return 0, ErrInvalidToken
+	}
+
+	return uint(binary.BigEndian.Uint64(plain)), nil
+}
@@ -0,0 +1,65 @@
+package character
+
+import (
+	"github.com/doruo/falloutdle/pkg/strings"
+)
+
+// AliasSource represents where an alias comes from
+type AliasSource string
+
+const (
+	RedirectAlias AliasSource = "redirect" // Wiki page redirecting to character page
+	TitleAlias    AliasSource = "title"    // Character infobox title
+)
+
+// Alias represents another name a character is known by, Rx: "The Master", "Mr. New Vegas"
+type Alias struct {
+	ID          uint        `json:"-" gorm:"primaryKey;autoIncrement"`
+	CharacterID uint        `json:"-" gorm:"uniqueIndex:idx_alias_character_folded"`
+	Name        string      `json:"name" gorm:"size:500"`
+	Folded      string      `json:"-" gorm:"size:500;index;uniqueIndex:idx_alias_character_folded"` // Name letters, to match guesses
+	Source      AliasSource `json:"source" gorm:"size:20"`
+}
+
+// TableName returns aliases table name
+func (Alias) TableName() string {
+	return "character_aliases"
+}
+
+// NewAlias creates a new Alias instance
+func NewAlias(characterID uint, name string, source AliasSource) *Alias {
+	return &Alias{
+		CharacterID: characterID,
+		Name:        name,
+		Folded:      strings.NormalizeLetters(name),
+		Source:      source,
+	}
+}
+
+// AddAlias adds name to character aliases, unless it is his name or already an alias.
+func (c *Character) AddAlias(name string, source AliasSource) {
+
+	folded := strings.NormalizeLetters(name)
+	if folded == "" || folded == strings.NormalizeLetters(c.Name) {
+		return
+	}
+
+	for _, alias := range c.Aliases {
+		if alias.Folded == folded {
+			return
+		}
+	}
+
+	c.Aliases = append(c.Aliases, *NewAlias(c.ID, name, source))
+}
+
+// AliasNames returns other names character is known by: titles and aliases
+func (c *Character) AliasNames() []string {
+
+	names := append([]string{}, c.Titles...)
+	for _, alias := range c.Aliases {
+		names = append(names, alias.Name)
+	}
+
+	return names
+}
@@ -57,6 +57,29 @@ func (g GameCode) GameFullName() string {
 	return string(g)
 }
 
+// ReleaseYear returns the release year of a game from its code, 0 if unknown
+func (g GameCode) ReleaseYear() int {
+	releaseYears := map[GameCode]int{
+		FO1:    1997,
+		FO2:    1998,
+		FOT:    2001,
+		FOBOS:  2004,
+		FO3:    2008,
+		FNV:    2010,
+		FO4:    2015,
+		FOS:    2015,
+		FOSBR:  2015,
+		FO76:   2018,
+		FBGNC:  2018,
+		FOWW:   2018,
+		FOSO:   2020,
+		FO76SD: 2020,
+		FO76SR: 2021,
+	}
+
+	return releaseYears[g]
+}
+
 // NormalizeGameCodes converts comma-separated game codes to slice
 func NormalizeGameCodes(gamesStr string) []string {
 	if gamesStr == "" {
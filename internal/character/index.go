@@ -0,0 +1,292 @@
+package character
+
+import (
+	"cmp"
+	"slices"
+	gostrings "strings"
+	"sync"
+
+	"github.com/doruo/falloutdle/pkg/strings"
+)
+
+const (
+	thumbnailWidth       = 64  // Search results thumbnails width, in pixels
+	trigramMinSimilarity = 0.3 // Lowest trigram similarity kept as a match
+)
+
+// SearchResult represents a character matching a search query
+type SearchResult struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	WikiTitle string  `json:"wiki_title"`
+	Thumbnail string  `json:"thumbnail,omitempty"`
+	Match     string  `json:"match"` // Name, wiki title or alias matching the query
+	Score     float64 `json:"score"`
+}
+
+// NewSearchResult creates a new SearchResult of character, with its thumbnail
+func NewSearchResult(char *Character) SearchResult {
+	return SearchResult{
+		ID:        char.ID,
+		Name:      char.Name,
+		WikiTitle: char.WikiTitle,
+		Thumbnail: char.GetImageURL(thumbnailWidth),
+	}
+}
+
+// searchRow represents a character found by database search
+type searchRow struct {
+	ID        uint
+	Name      string
+	WikiTitle string
+	ImageURL  string
+	Match     string
+	Score     float64
+}
+
+// result returns row as a search result, with its thumbnail.
+func (row *searchRow) result() SearchResult {
+
+	char := Character{ID: row.ID, Name: row.Name, WikiTitle: row.WikiTitle, ImageURL: row.ImageURL}
+
+	result := NewSearchResult(&char)
+	result.Match = row.Match
+	result.Score = row.Score
+
+	return result
+}
+
+// indexTerm represents a searchable name of a character
+type indexTerm struct {
+	character int    // Character position in index
+	text      string // Original name
+	folded    string // Folded name words
+	trigrams  int    // Distinct trigrams count
+}
+
+// indexKey represents a term suffix starting at one of its words, for words prefix search
+type indexKey struct {
+	key  string
+	term int
+	full bool // Key is the whole term
+}
+
+// Index is an in-memory prefix and trigram index of characters names, wiki titles and aliases.
+// It is rebuilt from loader on the first search after being invalidated.
+type Index struct {
+	mutex      sync.RWMutex
+	loader     func() ([]Character, error)
+	stale      bool
+	all        []Character // Indexed characters, as loaded
+	characters []SearchResult
+	terms      []indexTerm
+	keys       []indexKey       // Sorted by key
+	trigrams   map[string][]int // Terms positions by trigram
+}
+
+// NewIndex creates a new stale Index, built from loader characters on first search
+func NewIndex(loader func() ([]Character, error)) *Index {
+	return &Index{loader: loader, stale: true}
+}
+
+// Invalidate marks index as stale, to rebuild it on next search.
+func (idx *Index) Invalidate() {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	idx.stale = true
+}
+
+// Characters returns all indexed characters, without loading them again if index is up to date.
+func (idx *Index) Characters() ([]Character, error) {
+
+	if err := idx.refresh(); err != nil {
+		return nil, err
+	}
+
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+
+	return idx.all, nil
+}
+
+// Search returns at most limit characters best matching query, best first.
+// Whole names prefixes come first, then words prefixes, then names with similar trigrams.
+func (idx *Index) Search(query string, limit int) ([]SearchResult, error) {
+
+	if err := idx.refresh(); err != nil {
+		return nil, err
+	}
+
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+
+	folded := strings.FoldWords(query)
+	results := make([]SearchResult, 0, limit)
+	if folded == "" || limit <= 0 {
+		return results, nil
+	}
+
+	best := make(map[int]SearchResult)
+	keep := func(term int, score float64) {
+		position := idx.terms[term].character
+		if current, exists := best[position]; !exists || score > current.Score {
+			result := idx.characters[position]
+			result.Match = idx.terms[term].text
+			result.Score = score
+			best[position] = result
+		}
+	}
+
+	// Prefix matches, shorter names being closer to the query
+	start, _ := slices.BinarySearchFunc(idx.keys, folded, func(k indexKey, target string) int {
+		return gostrings.Compare(k.key, target)
+	})
+
+	for _, k := range idx.keys[start:] {
+
+		if !gostrings.HasPrefix(k.key, folded) {
+			break
+		}
+
+		score := 2 + float64(len(folded))/float64(len(k.key))
+		if k.full {
+			score++
+		}
+		keep(k.term, score)
+	}
+
+	// Trigram matches, for typos
+	queryTrigrams := trigrams(folded)
+	shared := make(map[int]int)
+
+	for _, trigram := range queryTrigrams {
+		for _, term := range idx.trigrams[trigram] {
+			shared[term]++
+		}
+	}
+
+	for term, count := range shared {
+
+		total := len(queryTrigrams) + idx.terms[term].trigrams - count
+		similarity := float64(count) / float64(total)
+
+		if similarity >= trigramMinSimilarity {
+			keep(term, similarity)
+		}
+	}
+
+	for _, result := range best {
+		results = append(results, result)
+	}
+
+	slices.SortFunc(results, func(a, b SearchResult) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+		return cmp.Or(cmp.Compare(len(a.Name), len(b.Name)), cmp.Compare(a.Name, b.Name))
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+// refresh rebuilds index from loader characters if stale.
+func (idx *Index) refresh() error {
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+
+	if !idx.stale {
+		return nil
+	}
+
+	characters, err := idx.loader()
+	if err != nil {
+		return err
+	}
+
+	idx.build(characters)
+	idx.stale = false
+
+	return nil
+}
+
+// build indexes characters names, wiki titles and aliases.
+func (idx *Index) build(characters []Character) {
+
+	idx.all = characters
+	idx.characters = make([]SearchResult, len(characters))
+	idx.terms = make([]indexTerm, 0, len(characters)*2)
+	idx.keys = make([]indexKey, 0, len(characters)*4)
+	idx.trigrams = make(map[string][]int)
+
+	for position, char := range characters {
+
+		idx.characters[position] = NewSearchResult(&char)
+
+		for _, text := range char.SearchTerms() {
+			idx.add(position, text)
+		}
+	}
+
+	slices.SortFunc(idx.keys, func(a, b indexKey) int {
+		return gostrings.Compare(a.key, b.key)
+	})
+}
+
+// add indexes a searchable name of character at position, once per character.
+func (idx *Index) add(position int, text string) {
+
+	folded := strings.FoldWords(text)
+	if folded == "" {
+		return
+	}
+
+	// Same name already indexed for this character, Rx: name and wiki title
+	for i := len(idx.terms) - 1; i >= 0 && idx.terms[i].character == position; i-- {
+		if idx.terms[i].folded == folded {
+			return
+		}
+	}
+
+	term := len(idx.terms)
+	termTrigrams := trigrams(folded)
+	idx.terms = append(idx.terms, indexTerm{character: position, text: text, folded: folded, trigrams: len(termTrigrams)})
+
+	idx.keys = append(idx.keys, indexKey{key: folded, term: term, full: true})
+	for i := range len(folded) {
+		if folded[i] == ' ' {
+			idx.keys = append(idx.keys, indexKey{key: folded[i+1:], term: term})
+		}
+	}
+
+	for _, trigram := range termTrigrams {
+		idx.trigrams[trigram] = append(idx.trigrams[trigram], term)
+	}
+}
+
+// trigrams returns distinct trigrams of folded words, each word padded with spaces.
+// Rx: "cat" -> "  c", " ca", "cat", "at "
+func trigrams(folded string) []string {
+
+	seen := make(map[string]bool)
+	var result []string
+
+	for _, word := range gostrings.Fields(folded) {
+
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+
+			trigram := string(padded[i : i+3])
+			if !seen[trigram] {
+				seen[trigram] = true
+				result = append(result, trigram)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,191 @@
+package character
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid page cursor")
+	ErrInvalidSort   = errors.New("invalid sort")
+)
+
+const (
+	DefaultPageSize = 20  // Characters listed without limit
+	MaxPageSize     = 100 // Most characters listed in a page
+)
+
+// Sort represents a characters listing order, descending if prefixed with "-"
+type Sort string
+
+const (
+	SortByName     Sort = "name"
+	SortByNameDesc Sort = "-name"
+	SortByID       Sort = "id"
+	SortByIDDesc   Sort = "-id"
+)
+
+// Fields ordering characters, by sort
+var sortColumns = map[Sort]string{
+	SortByName:     "name",
+	SortByNameDesc: "name",
+	SortByID:       "id",
+	SortByIDDesc:   "id",
+}
+
+// IsValid determines if sort is a known listing order
+func (s Sort) IsValid() bool {
+	_, exists := sortColumns[s]
+	return exists
+}
+
+// IsDesc determines if sort is in descending order
+func (s Sort) IsDesc() bool {
+	return len(s) > 0 && s[0] == '-'
+}
+
+// compare returns characters order in sort, by ID when sort values are equal
+func (s Sort) compare(a, b *Character) int {
+
+	order := 0
+	if sortColumns[s] == "name" {
+		order = cmp.Compare(a.Name, b.Name)
+	}
+	if order == 0 {
+		order = cmp.Compare(a.ID, b.ID)
+	}
+
+	if s.IsDesc() {
+		return -order
+	}
+	return order
+}
+
+// ListFilter represents characters listing filters, empty ones are ignored
+type ListFilter struct {
+	Game        string // Game code the character appears in
+	Race        string
+	Gender      string
+	Status      string
+	Affiliation string
+}
+
+// Matches determines if character matches every filter, ignoring case except for game codes
+func (f ListFilter) Matches(char *Character) bool {
+
+	matches := func(filter, value string) bool {
+		return filter == "" || strings.EqualFold(filter, value)
+	}
+
+	if f.Game != "" && !slices.Contains(char.Games, f.Game) {
+		return false
+	}
+
+	if f.Affiliation != "" && !slices.ContainsFunc(char.Affiliation, func(affiliation string) bool {
+		return strings.EqualFold(affiliation, f.Affiliation)
+	}) {
+		return false
+	}
+
+	return matches(f.Race, char.Race) && matches(f.Gender, char.Gender) && matches(f.Status, char.Status)
+}
+
+// listPage returns at most limit characters matching filter in sort order, listed after cursor if any.
+func listPage(characters []Character, filter ListFilter, sort Sort, after *cursor, limit int) []Character {
+
+	var last *Character
+	if after != nil {
+		last = &Character{ID: after.ID, Name: after.Name}
+	}
+
+	var matches []Character
+	for i := range characters {
+		if filter.Matches(&characters[i]) && (last == nil || sort.compare(&characters[i], last) > 0) {
+			matches = append(matches, characters[i])
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b Character) int { return sort.compare(&a, &b) })
+
+	return matches[:min(len(matches), limit)]
+}
+
+// Page represents a page of listed characters
+type Page struct {
+	Characters []ListItem `json:"characters"`
+	NextCursor string     `json:"next_cursor,omitempty"` // Empty on last page
+}
+
+// ListItem represents a listed character, without any daily puzzle information
+type ListItem struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	WikiTitle   string   `json:"wiki_title"`
+	Games       []string `json:"games"`
+	Race        string   `json:"race"`
+	Gender      string   `json:"gender"`
+	Status      string   `json:"status"`
+	Affiliation []string `json:"affiliation"`
+	MainGame    string   `json:"main_game"`
+	Thumbnail   string   `json:"thumbnail,omitempty"`
+}
+
+// NewListItem creates a new ListItem of character
+func NewListItem(char *Character) ListItem {
+	return ListItem{
+		ID:          char.ID,
+		Name:        char.Name,
+		WikiTitle:   char.WikiTitle,
+		Games:       char.Games,
+		Race:        char.Race,
+		Gender:      char.Gender,
+		Status:      char.Status,
+		Affiliation: char.Affiliation,
+		MainGame:    char.MainGame,
+		Thumbnail:   char.GetImageURL(thumbnailWidth),
+	}
+}
+
+// cursor represents the last listed character of a page, next page starts after it
+type cursor struct {
+	Sort Sort   `json:"s"`
+	Name string `json:"n,omitempty"`
+	ID   uint   `json:"i"`
+}
+
+// newCursor creates the cursor of a page ending with char, listed in sort order
+func newCursor(sort Sort, char *Character) *cursor {
+
+	c := &cursor{Sort: sort, ID: char.ID}
+	if sortColumns[sort] == "name" {
+		c.Name = char.Name
+	}
+
+	return c
+}
+
+// encode returns cursor as an opaque URL safe string.
+func (c *cursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor returns cursor from its opaque string, listed in sort order.
+func decodeCursor(value string, sort Sort) (*cursor, error) {
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Sort != sort || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
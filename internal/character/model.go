@@ -2,10 +2,14 @@ package character
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 )
 
+// Wiki files URL, redirecting to the image of a file name
+const imageBaseURL = "https://fallout.fandom.com/wiki/Special:FilePath/"
+
 var mainCharacters = []string{
 	"Vault Dweller",
 	"Chosen One",
@@ -28,10 +32,12 @@ type Character struct {
 	Affiliation []string `json:"affiliation" gorm:"serializer:json"`
 	Role        string   `json:"role" gorm:"size:255"`
 	Titles      []string `json:"titles" gorm:"serializer:json"`
+	Actors      []string `json:"actors" gorm:"serializer:json"`   // Voice actors
 	MainGame    string   `json:"main_game" gorm:"size:100;index"` // Primary game of origin
 	ImageURL    string   `json:"image_url" gorm:"type:text"`
+	Aliases     []Alias  `json:"aliases,omitempty" gorm:"constraint:OnDelete:CASCADE"` // Other known names
 
-	PlayedAt *time.Time `json:"played_at,omitempty" gorm:"index"` // last played date
+	PlayedAt *time.Time `json:"-" gorm:"index"` // last played date, never exposed as it gives away daily answers
 }
 
 // NewCharacter creates a new Character instance
@@ -43,6 +49,7 @@ func NewCharacter(name, wikiTitle string) *Character {
 		Mentions:    make([]string, 0),
 		Affiliation: make([]string, 0),
 		Titles:      make([]string, 0),
+		Actors:      make([]string, 0),
 	}
 }
 
@@ -66,10 +73,60 @@ func (c *Character) IsMainCharacter() bool {
 	return false
 }
 
+// HasActor determines if character is voiced by the given actor
+func (c *Character) HasActor(actor string) bool {
+	for _, a := range c.Actors {
+		if strings.EqualFold(a, actor) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetImageURL returns character image full URL, scaled down to width if not zero.
+func (c *Character) GetImageURL(width int) string {
+
+	if c.ImageURL == "" {
+		return ""
+	}
+
+	imageURL := c.ImageURL
+	if !strings.HasPrefix(imageURL, "http") {
+		imageURL = imageBaseURL + url.PathEscape(strings.ReplaceAll(imageURL, " ", "_"))
+	}
+
+	if width > 0 {
+		imageURL += fmt.Sprintf("?width=%d", width)
+	}
+
+	return imageURL
+}
+
+// SearchTerms returns names character can be searched by: name, wiki title, titles and aliases
+func (c *Character) SearchTerms() []string {
+	terms := []string{c.Name, c.WikiTitle}
+	return append(terms, c.AliasNames()...)
+}
+
+// IsComplete checks if character has enough attributes to be guessed
+func (c *Character) IsComplete() bool {
+
+	if c.Name == "" || c.Race == "" {
+		return false
+	}
+
+	return len(c.Games) > 0 || c.MainGame != ""
+}
+
 func (c *Character) IsPlayed() bool {
 	return c.PlayedAt != nil
 }
 
+// IsPlayedBefore determines if character was last played before date
+func (c *Character) IsPlayedBefore(date time.Time) bool {
+	return c.PlayedAt != nil && c.PlayedAt.Before(date)
+}
+
 // /----- SETTER FUNCTIONS -----/
 
 func (c *Character) UpdateAsPlayed() *Character {
@@ -2,18 +2,45 @@ package character
 
 import (
 	"errors"
+	"strings"
+	"sync"
 
 	"gorm.io/gorm"
 )
 
 type Repository struct {
 	db *gorm.DB
+
+	trigramOnce sync.Once
+	trigram     bool // pg_trgm extension available
 }
 
 func NewCharacterRepository(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
+// OnChange registers fn to be called after characters or their aliases are created, updated or deleted.
+// Name identifies the registered callbacks, it must be unique.
+func (r *Repository) OnChange(name string, fn func()) error {
+
+	callback := func(tx *gorm.DB) {
+		table := tx.Statement.Table
+		if tx.Error == nil && (table == "characters" || table == Alias{}.TableName()) {
+			fn()
+		}
+	}
+
+	callbacks := r.db.Callback()
+	if err := callbacks.Create().After("gorm:create").Register(name+":create", callback); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(name+":update", callback); err != nil {
+		return err
+	}
+
+	return callbacks.Delete().After("gorm:delete").Register(name+":delete", callback)
+}
+
 // /----- CREATE -----/
 
 // Add creates a new character record in the database
@@ -70,6 +97,90 @@ func (r *Repository) GetAll(limit, offset int) ([]Character, error) {
 	return characters, nil
 }
 
+// GetAllWithAliases retrieves all characters with their aliases
+func (r *Repository) GetAllWithAliases() ([]Character, error) {
+
+	var characters []Character
+	result := r.db.Preload("Aliases").Find(&characters)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return characters, nil
+}
+
+// Search retrieves at most limit characters best matching query by name, wiki title or alias, best first.
+// Ranks by trigram similarity with pg_trgm, names starting with query first.
+// Falls back to names containing query if the extension is not available.
+func (r *Repository) Search(query string, limit int) ([]SearchResult, error) {
+
+	// Score and condition of a matching column
+	score := "CASE WHEN {column} ILIKE @prefix THEN 1 ELSE 0.5 END"
+	condition := "{column} ILIKE @contains"
+
+	if r.hasTrigram() {
+		score = "similarity({column}, @query) + CASE WHEN {column} ILIKE @prefix THEN 1 ELSE 0 END"
+		condition = "({column} % @query OR {column} ILIKE @prefix)"
+	}
+
+	matches := func(table, id, column string) string {
+		return strings.ReplaceAll("SELECT "+id+" AS character_id, {column} AS match, "+score+" AS score "+
+			"FROM "+table+" WHERE "+condition, "{column}", column)
+	}
+
+	sql := `WITH matches AS (` +
+		matches("characters", "id", "name") + ` UNION ALL ` +
+		matches("characters", "id", "wiki_title") + ` UNION ALL ` +
+		matches("character_aliases", "character_id", "name") + `
+	), best AS (
+		SELECT DISTINCT ON (character_id) character_id, match, score FROM matches ORDER BY character_id, score DESC
+	)
+	SELECT c.id, c.name, c.wiki_title, c.image_url, best.match, best.score
+	FROM best JOIN characters c ON c.id = best.character_id
+	ORDER BY best.score DESC, LENGTH(c.name), c.name
+	LIMIT @limit`
+
+	pattern := escapeLike(query)
+
+	var rows []searchRow
+	result := r.db.Raw(sql, map[string]any{
+		"query":    query,
+		"prefix":   pattern + "%",
+		"contains": "%" + pattern + "%",
+		"limit":    limit,
+	}).Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]SearchResult, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, row.result())
+	}
+
+	return results, nil
+}
+
+// hasTrigram determines if pg_trgm extension is available, checked once.
+func (r *Repository) hasTrigram() bool {
+
+	r.trigramOnce.Do(func() {
+		query := r.db.Raw("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm')").Scan(&r.trigram)
+		if query.Error != nil {
+			r.trigram = false
+		}
+	})
+
+	return r.trigram
+}
+
+// escapeLike escapes LIKE wildcards of value, to match it literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
 // GetByID retrieves a character by its ID
 func (r *Repository) GetByID(id uint) (*Character, error) {
 
@@ -78,7 +189,7 @@ func (r *Repository) GetByID(id uint) (*Character, error) {
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, errors.New("character not found")
+			return nil, ErrCharacterNotFound
 		}
 		return nil, result.Error
 	}
@@ -93,7 +204,7 @@ func (r *Repository) GetByWikiTitle(wikiTitle string) (*Character, error) {
 	result := r.db.Where("wiki_title = ?", wikiTitle).First(&character)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, errors.New("character not found")
+			return nil, ErrCharacterNotFound
 		}
 		return nil, result.Error
 	}
@@ -109,7 +220,7 @@ func (r *Repository) GetByName(name string) (*Character, error) {
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, errors.New("character not found")
+			return nil, ErrCharacterNotFound
 		}
 		return nil, result.Error
 	}
@@ -142,6 +253,32 @@ func (r *Repository) Update(character *Character) error {
 	return nil
 }
 
+// SetAliases replaces character aliases with given ones
+func (r *Repository) SetAliases(characterID uint, aliases []Alias) error {
+
+	if characterID == 0 {
+		return errors.New("invalid character ID")
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := tx.Where("character_id = ?", characterID).Delete(&Alias{}).Error; err != nil {
+			return err
+		}
+
+		if len(aliases) == 0 {
+			return nil
+		}
+
+		for i := range aliases {
+			aliases[i].ID = 0
+			aliases[i].CharacterID = characterID
+		}
+
+		return tx.Create(&aliases).Error
+	})
+}
+
 // /----- DELETE -----/
 
 // Delete removes a character by ID
@@ -0,0 +1,139 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/doruo/falloutdle/pkg/strings"
+)
+
+var ErrAmbiguousName = errors.New("ambiguous character name")
+
+const (
+	maxCandidates   = 5 // Most "did you mean" candidates of an ambiguous name
+	lettersPerTypo  = 3 // Name letters count allowing one more typo
+	maxTypoDistance = 3 // Most typos tolerated in a name
+)
+
+// AmbiguousNameError is returned when a name matches several characters equally well
+type AmbiguousNameError struct {
+	Name       string
+	Candidates []SearchResult // "Did you mean" characters
+}
+
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrAmbiguousName, e.Name)
+}
+
+// Is makes AmbiguousNameError match ErrAmbiguousName with errors.Is
+func (e *AmbiguousNameError) Is(target error) bool {
+	return target == ErrAmbiguousName
+}
+
+// ResolveName returns the character of characters a guessed name refers to. Tries in order
+// exact wiki title, exact name, name or wiki title ignoring case, accents and punctuation, aliases, then nearest names
+// with a few typos. Fails with an AmbiguousNameError if several characters match at the same step.
+func ResolveName(name string, characters []Character) (*Character, error) {
+
+	folded := strings.NormalizeLetters(name)
+	if folded == "" {
+		return nil, ErrCharacterNotFound
+	}
+
+	// Exact wiki title, unique, so that any candidate sharing a name can be picked.
+	// Rx: "Dogmeat (Fallout 4)" or "Dogmeat_(Fallout_4)"
+	matches := filter(characters, func(char *Character) bool {
+		return char.WikiTitle == name || strings.UnnormalizeString(char.WikiTitle) == name
+	})
+
+	if len(matches) > 0 {
+		return pick(name, matches)
+	}
+
+	// Exact name, several characters may share it. Rx: "Dogmeat"
+	matches = filter(characters, func(char *Character) bool {
+		return char.Name == name
+	})
+
+	if len(matches) > 0 {
+		return pick(name, matches)
+	}
+
+	// Case, accents and punctuation
+	matches = filter(characters, func(char *Character) bool {
+		return strings.NormalizeLetters(char.Name) == folded || strings.NormalizeLetters(char.WikiTitle) == folded
+	})
+
+	if len(matches) > 0 {
+		return pick(name, matches)
+	}
+
+	// Titles and aliases, Rx: "Mr House"
+	matches = filter(characters, func(char *Character) bool {
+		for _, alias := range char.AliasNames() {
+			if strings.NormalizeLetters(alias) == folded {
+				return true
+			}
+		}
+		return false
+	})
+
+	if len(matches) > 0 {
+		return pick(name, matches)
+	}
+
+	// Nearest names, typos allowed growing with name length
+	best := min(max(len([]rune(folded))/lettersPerTypo, 1), maxTypoDistance)
+	matches = nil
+
+	for i := range characters {
+
+		distance := best + 1
+		for _, term := range characters[i].SearchTerms() {
+			distance = min(distance, strings.Levenshtein(folded, strings.NormalizeLetters(term)))
+		}
+
+		switch {
+		case distance < best:
+			best = distance
+			matches = []Character{characters[i]}
+		case distance == best:
+			matches = append(matches, characters[i])
+		}
+	}
+
+	if len(matches) > 0 {
+		return pick(name, matches)
+	}
+
+	return nil, ErrCharacterNotFound
+}
+
+// pick returns the only matching character, or fails with matches as candidates.
+func pick(name string, matches []Character) (*Character, error) {
+
+	if len(matches) == 1 {
+		char := matches[0]
+		return &char, nil
+	}
+
+	candidates := make([]SearchResult, 0, maxCandidates)
+	for i := range matches[:min(len(matches), maxCandidates)] {
+		candidates = append(candidates, NewSearchResult(&matches[i]))
+	}
+
+	return nil, &AmbiguousNameError{Name: name, Candidates: candidates}
+}
+
+// filter returns characters matching predicate.
+func filter(characters []Character, predicate func(*Character) bool) []Character {
+
+	var matches []Character
+	for i := range characters {
+		if predicate(&characters[i]) {
+			matches = append(matches, characters[i])
+		}
+	}
+
+	return matches
+}
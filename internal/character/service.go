@@ -1,20 +1,41 @@
 package character
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/doruo/falloutdle/pkg/random"
+	"github.com/doruo/falloutdle/pkg/strings"
+)
+
+var (
+	ErrCharacterNotFound = errors.New("character not found")
+	ErrInvalidQuery      = errors.New("invalid search query")
+	ErrInvalidName       = errors.New("invalid character name")
 )
 
 // characterService implements Repository using CharacterRepository
 type Service struct {
-	repo *Repository
+	repo  *Repository
+	index *Index
 }
 
-// NewCharacterService creates a new character service
+// NewCharacterService creates a new character service,
+// with a search index rebuilt whenever characters change
 func NewCharacterService(repo *Repository) *Service {
-	return &Service{repo: repo}
+
+	index := NewIndex(func() ([]Character, error) {
+		return repo.GetAllWithAliases()
+	})
+
+	if err := repo.OnChange(fmt.Sprintf("character:index:%p", index), index.Invalidate); err != nil {
+		fmt.Println("Character index callbacks error:", err)
+	}
+
+	return &Service{repo: repo, index: index}
 }
 
 // /----- GET FUNCTIONS -----/
@@ -34,6 +55,29 @@ func (s *Service) GetByID(id int) (*Character, error) {
 	return char, nil
 }
 
+// GetByIDs retrieves characters by IDs from the index, in IDs order. Unknown IDs are left out.
+func (s *Service) GetByIDs(ids []uint) ([]Character, error) {
+
+	characters, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	byID := make(map[uint]*Character, len(characters))
+	for i := range characters {
+		byID[characters[i].ID] = &characters[i]
+	}
+
+	found := make([]Character, 0, len(ids))
+	for _, id := range ids {
+		if char, exists := byID[id]; exists {
+			found = append(found, *char)
+		}
+	}
+
+	return found, nil
+}
+
 func (s *Service) GetByWikiTitle(title string) (*Character, error) {
 
 	if title == "" {
@@ -48,10 +92,173 @@ func (s *Service) GetByWikiTitle(title string) (*Character, error) {
 	return char, nil
 }
 
-// GetAllValidCharacters retrieves all valid characters for the game
+// GetByName retrieves a character by name
+func (s *Service) GetByName(name string) (*Character, error) {
+
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+
+	char, err := s.repo.GetByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get character from name %s: %w", name, err)
+	}
+
+	return char, nil
+}
+
+// Resolve retrieves the character a guessed name refers to, tolerating case, accents,
+// aliases and typos. Fails with an AmbiguousNameError holding candidates if several characters match.
+func (s *Service) Resolve(name string) (*Character, error) {
+
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+
+	// Exact name is tried first too, from every character sharing it
+	characters, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	char, err := ResolveName(name, characters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve character from name %s: %w", name, err)
+	}
+
+	return char, nil
+}
+
+// GetByNormalizedName retrieves a character by name, ignoring case, accents and punctuation
+func (s *Service) GetByNormalizedName(name string) (*Character, error) {
+
+	normalized := strings.NormalizeLetters(name)
+	if normalized == "" {
+		return nil, ErrInvalidName
+	}
+
+	characters, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	// Exact name first
+	for _, char := range characters {
+		if char.Name == name {
+			return &char, nil
+		}
+	}
+
+	for _, char := range characters {
+		if strings.NormalizeLetters(char.Name) == normalized {
+			return &char, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to get character from name %s: %w", name, ErrCharacterNotFound)
+}
+
+// List returns a page of characters matching filter in sort order from the index, starting after cursor if not empty.
+func (s *Service) List(filter ListFilter, sort Sort, after string, limit int) (*Page, error) {
+
+	if !sort.IsValid() {
+		return nil, ErrInvalidSort
+	}
+
+	var start *cursor
+	if after != "" {
+
+		var err error
+		if start, err = decodeCursor(after, sort); err != nil {
+			return nil, err
+		}
+	}
+
+	limit = min(max(limit, 1), MaxPageSize)
+
+	all, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list characters: %w", err)
+	}
+
+	// One more character tells if there is a next page
+	characters := listPage(all, filter, sort, start, limit+1)
+
+	page := &Page{Characters: make([]ListItem, 0, limit)}
+
+	if len(characters) > limit {
+		characters = characters[:limit]
+		page.NextCursor = newCursor(sort, &characters[limit-1]).encode()
+	}
+
+	for i := range characters {
+		page.Characters = append(page.Characters, NewListItem(&characters[i]))
+	}
+
+	return page, nil
+}
+
+// Search returns at most limit characters best matching query by name, wiki title or alias,
+// from the in-memory index first then from the database
+func (s *Service) Search(query string, limit int) ([]SearchResult, error) {
+
+	if strings.NormalizeLetters(query) == "" {
+		return nil, ErrInvalidQuery
+	}
+
+	results, err := s.index.Search(query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search characters: %w", err)
+	}
+
+	// Nothing found in index, database ranked search as a second chance
+	if len(results) == 0 {
+		results, err = s.repo.Search(query, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search characters in database: %w", err)
+		}
+	}
+
+	return results, nil
+}
+
+// GetByActor retrieves all characters voiced by the given actor
+func (s *Service) GetByActor(actor string) ([]Character, error) {
+
+	if actor == "" {
+		return nil, errors.New("invalid actor")
+	}
+
+	characters, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	var roles []Character
+	for _, char := range characters {
+		if char.HasActor(actor) {
+			roles = append(roles, char)
+		}
+	}
+
+	return roles, nil
+}
+
+// GetAll retrieves every character from the index, played ones included
+func (s *Service) GetAll() ([]Character, error) {
+
+	characters, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	return characters, nil
+}
+
+// GetAllValidCharacters retrieves all valid characters for the game from the index
 func (s *Service) GetAllValidCharacters() ([]Character, error) {
 
-	characters, err := s.repo.GetAll(0, 0)
+	characters, err := s.index.Characters()
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get characters: %w", err)
@@ -68,6 +275,28 @@ func (s *Service) GetAllValidCharacters() ([]Character, error) {
 	return validCharacters, nil
 }
 
+// GetDailyCandidates retrieves characters a daily puzzle of date can be made of, by ID order.
+// Characters played on date stay candidates, so date puzzles are the same whenever generated.
+func (s *Service) GetDailyCandidates(date time.Time) ([]Character, error) {
+
+	characters, err := s.index.Characters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters: %w", err)
+	}
+
+	var candidates []Character
+	for _, char := range characters {
+		if char.IsComplete() && !char.IsPlayedBefore(date) {
+			candidates = append(candidates, char)
+		}
+	}
+
+	// Same order whatever rows order in database
+	slices.SortFunc(candidates, func(a, b Character) int { return cmp.Compare(a.ID, b.ID) })
+
+	return candidates, nil
+}
+
 // GetRandomCharacter selects a random character
 func (s *Service) GetRandomCharacter() (*Character, error) {
 
@@ -109,6 +338,20 @@ func (s *Service) UpdateAsPlayed(characterID uint) error {
 	return nil
 }
 
+// SetAliases saves character aliases, replacing previous ones, as when its wiki page is fetched again
+func (s *Service) SetAliases(char *Character) error {
+
+	if char == nil || char.ID == 0 {
+		return errors.New("invalid character")
+	}
+
+	if err := s.repo.SetAliases(char.ID, char.Aliases); err != nil {
+		return fmt.Errorf("failed to set character aliases: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateAsUnplayed set a character as unplayed
 func (s *Service) UpdateAsUnplayed(characterID uint) error {
 
@@ -133,14 +376,5 @@ func (s *Service) UpdateAsUnplayed(characterID uint) error {
 
 // isValidForGame checks if a character is valid for the game
 func (s *Service) IsValidForGame(char *Character) bool {
-
-	if char.Name == "" || char.Race == "" {
-		return false
-	}
-
-	if len(char.Games) == 0 && char.MainGame == "" {
-		return false
-	}
-
-	return !char.IsPlayed()
+	return char.IsComplete() && !char.IsPlayed()
 }
@@ -0,0 +1,86 @@
+package character
+
+// View represents a character as shown publicly, without any daily puzzle information
+type View struct {
+	ID          uint       `json:"id"`
+	Name        string     `json:"name"`
+	WikiTitle   string     `json:"wiki_title"`
+	Games       []GameView `json:"games"`
+	Mentions    []GameView `json:"mentions"`
+	MainGame    *GameView  `json:"main_game,omitempty"`
+	Race        string     `json:"race"`
+	Gender      string     `json:"gender"`
+	Status      string     `json:"status"`
+	Affiliation []string   `json:"affiliation"`
+	Role        string     `json:"role"`
+	Titles      []string   `json:"titles"`
+	Actors      []string   `json:"actors"`
+	Aliases     []string   `json:"aliases"`
+	ImageURL    string     `json:"image_url,omitempty"`
+	Thumbnail   string     `json:"thumbnail,omitempty"`
+}
+
+// GameView represents a game with its resolved full name
+type GameView struct {
+	Code        GameCode `json:"code"`
+	Name        string   `json:"name"`
+	ReleaseYear int      `json:"release_year,omitempty"`
+}
+
+// NewGameView creates a new GameView from game code
+func NewGameView(code string) GameView {
+	game := GameCode(code)
+	return GameView{Code: game, Name: game.GameFullName(), ReleaseYear: game.ReleaseYear()}
+}
+
+// View returns character as shown publicly.
+func (c *Character) View() *View {
+
+	view := &View{
+		ID:          c.ID,
+		Name:        c.Name,
+		WikiTitle:   c.WikiTitle,
+		Games:       newGameViews(c.Games),
+		Mentions:    newGameViews(c.Mentions),
+		Race:        c.Race,
+		Gender:      c.Gender,
+		Status:      c.Status,
+		Affiliation: nonNil(c.Affiliation),
+		Role:        c.Role,
+		Titles:      nonNil(c.Titles),
+		Actors:      nonNil(c.Actors),
+		Aliases:     make([]string, 0, len(c.Aliases)),
+		ImageURL:    c.GetImageURL(0),
+		Thumbnail:   c.GetImageURL(thumbnailWidth),
+	}
+
+	if c.MainGame != "" {
+		game := NewGameView(c.MainGame)
+		view.MainGame = &game
+	}
+
+	for _, alias := range c.Aliases {
+		view.Aliases = append(view.Aliases, alias.Name)
+	}
+
+	return view
+}
+
+// newGameViews returns game views of codes.
+func newGameViews(codes []string) []GameView {
+
+	views := make([]GameView, 0, len(codes))
+	for _, code := range codes {
+		views = append(views, NewGameView(code))
+	}
+
+	return views
+}
+
+// nonNil returns values, or an empty slice if nil, to be encoded as an empty JSON array.
+func nonNil(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+	return values
+}
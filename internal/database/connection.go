@@ -5,7 +5,13 @@ import (
 	"log"
 	"os"
 
+	"github.com/doruo/falloutdle/internal/achievement"
+	"github.com/doruo/falloutdle/internal/challenge"
 	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/internal/score"
+	"github.com/doruo/falloutdle/internal/stats"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -41,10 +47,27 @@ func NewDatabaseConnection() (db *gorm.DB) {
 	}
 
 	// Auto-migration
-	err = db.AutoMigrate(&character.Character{})
+	err = db.AutoMigrate(
+		&character.Character{},
+		&character.Alias{},
+		&score.Score{},
+		&challenge.Challenge{},
+		&challenge.Solve{},
+		&player.Player{},
+		&player.Session{},
+		&player.Progress{},
+		&player.TransferCode{},
+		&stats.Result{},
+		&stats.Stat{},
+		&league.League{},
+		&league.Member{},
+		&achievement.Unlock{},
+	)
 	if err != nil {
 		log.Fatal("Failed to migrate:", err)
 	}
 
+	migrateSearch(db)
+
 	return
 }
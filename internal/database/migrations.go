@@ -0,0 +1,40 @@
+package database
+
+import (
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// trigramIndex represents a trigram index on a text column, for fuzzy search
+type trigramIndex struct {
+	name   string
+	table  string
+	column string
+}
+
+// Characters names trigram indexes
+var trigramIndexes = []trigramIndex{
+	{name: "idx_characters_name_trgm", table: "characters", column: "name"},
+	{name: "idx_characters_wiki_title_trgm", table: "characters", column: "wiki_title"},
+	{name: "idx_character_aliases_name_trgm", table: "character_aliases", column: "name"},
+}
+
+// migrateSearch creates pg_trgm extension and characters names trigram indexes.
+// Without the extension, characters search still works in a slower ILIKE mode.
+func migrateSearch(db *gorm.DB) {
+
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		log.Printf("WARNING: pg_trgm extension not available, characters search degraded to ILIKE: %v", err)
+		return
+	}
+
+	for _, index := range trigramIndexes {
+
+		sql := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING gin (%s gin_trgm_ops)", index.name, index.table, index.column)
+		if err := db.Exec(sql).Error; err != nil {
+			log.Printf("WARNING: failed to create index %s: %v", index.name, err)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package game
+
+import "github.com/doruo/falloutdle/internal/character"
+
+// ActorClue represents actor mode clue: answer voice actors and their other roles
+type ActorClue struct {
+	Actors []string    `json:"actors"`
+	Roles  []ActorRole `json:"roles"`
+}
+
+// ActorRole represents another character voiced by one of the answer actors
+type ActorRole struct {
+	Actor     string   `json:"actor"`
+	Character string   `json:"character"`
+	Games     []string `json:"games"`
+}
+
+func NewActorClue(actors []string) *ActorClue {
+	return &ActorClue{
+		Actors: actors,
+		Roles:  make([]ActorRole, 0),
+	}
+}
+
+// AddRole adds character as another role of actor
+func (ac *ActorClue) AddRole(actor string, c *character.Character) {
+	ac.Roles = append(ac.Roles, ActorRole{
+		Actor:     actor,
+		Character: c.Name,
+		Games:     c.Games,
+	})
+}
@@ -0,0 +1,159 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"github.com/doruo/falloutdle/internal/stats"
+	"github.com/doruo/falloutdle/pkg/bitmap"
+)
+
+const (
+	CardWidth  = 1200 // Share card width, social networks preview size
+	CardHeight = 630  // Share card height
+
+	cardMargin   = 64 // Space between card border and content
+	cardBorder   = 6  // Border line thickness
+	cardGridTop  = 220
+	cardGridSize = 740 // Grid area width, stats are drawn on its right
+	cardMaxCell  = 56  // Largest grid cell size
+	cardCellGap  = 6
+)
+
+// Pip-Boy terminal colors
+var (
+	cardBackground = color.RGBA{R: 0x08, G: 0x16, B: 0x0b, A: 0xff}
+	cardScanline   = color.RGBA{R: 0x05, G: 0x0f, B: 0x07, A: 0xff}
+	cardText       = color.RGBA{R: 0x1a, G: 0xff, B: 0x80, A: 0xff}
+	cardDimText    = color.RGBA{R: 0x12, G: 0x9e, B: 0x52, A: 0xff}
+
+	cellColors = map[Cell]color.RGBA{
+		GreenCell:  {R: 0x2e, G: 0xc4, B: 0x5a, A: 0xff},
+		YellowCell: {R: 0xe0, G: 0xc3, B: 0x41, A: 0xff},
+		RedCell:    {R: 0xa8, G: 0x32, B: 0x32, A: 0xff},
+		BlueCell:   {R: 0x3b, G: 0x6f, B: 0xc2, A: 0xff},
+		PurpleCell: {R: 0x8a, G: 0x4f, B: 0xb2, A: 0xff},
+		UpCell:     {R: 0xd9, G: 0x7a, B: 0x2b, A: 0xff}, // Wrong, but the answer is close in time
+		DownCell:   {R: 0xd9, G: 0x7a, B: 0x2b, A: 0xff},
+	}
+)
+
+// RenderCard draws a finished puzzle result as a spoiler-free share card:
+// puzzle title, result grid, attempts and streak on a Pip-Boy styled screen.
+func RenderCard(result *stats.Result) *image.RGBA {
+
+	img := image.NewRGBA(image.Rect(0, 0, CardWidth, CardHeight))
+	fill(img, img.Bounds(), cardBackground)
+
+	// Screen frame
+	frame := img.Bounds().Inset(cardMargin / 2)
+	fill(img, image.Rect(frame.Min.X, frame.Min.Y, frame.Max.X, frame.Min.Y+cardBorder), cardText)
+	fill(img, image.Rect(frame.Min.X, frame.Max.Y-cardBorder, frame.Max.X, frame.Max.Y), cardText)
+	fill(img, image.Rect(frame.Min.X, frame.Min.Y, frame.Min.X+cardBorder, frame.Max.Y), cardText)
+	fill(img, image.Rect(frame.Max.X-cardBorder, frame.Min.Y, frame.Max.X, frame.Max.Y), cardText)
+
+	title := fmt.Sprintf("%s #%d", strings.ToUpper(shareTitle), result.Number)
+	bitmap.DrawText(img, cardMargin, cardMargin, title, 10, cardText)
+	bitmap.DrawText(img, cardMargin, cardMargin+90, Mode(result.Mode).Title(), 5, cardDimText)
+
+	drawGrid(img, result.Grid)
+
+	// Stats on the right of the grid
+	x := cardMargin + cardGridSize + cardMargin
+	y := cardGridTop
+
+	status := "FAILED"
+	if result.Won {
+		status = "SOLVED"
+	}
+
+	bitmap.DrawText(img, x, y, status, 6, cardText)
+	bitmap.DrawText(img, x, y+100, "ATTEMPTS", 4, cardDimText)
+	bitmap.DrawText(img, x, y+140, fmt.Sprint(result.Guesses), 7, cardText)
+
+	if !result.Practice {
+		bitmap.DrawText(img, x, y+220, "STREAK", 4, cardDimText)
+		bitmap.DrawText(img, x, y+260, fmt.Sprint(result.Streak), 7, cardText)
+	}
+
+	// Scanlines over everything, as on a terminal screen
+	for row := 0; row < CardHeight; row += 4 {
+		darken(img, image.Rect(0, row, CardWidth, row+1))
+	}
+
+	return img
+}
+
+// drawGrid draws result grid rows as colored squares, scaled to fit grid area.
+func drawGrid(img *image.RGBA, grid []string) {
+
+	columns := 0
+	for _, row := range grid {
+		columns = max(columns, len(row))
+	}
+
+	if columns == 0 {
+		return
+	}
+
+	height := CardHeight - cardGridTop - cardMargin
+	size := min(cardMaxCell+cardCellGap, cardGridSize/columns, height/len(grid)) - cardCellGap
+	if size < 1 {
+		size = 1
+	}
+
+	for i, row := range grid {
+		for j, cell := range []byte(row) {
+
+			x := cardMargin + j*(size+cardCellGap)
+			y := cardGridTop + i*(size+cardCellGap)
+			rect := image.Rect(x, y, x+size, y+size)
+
+			fill(img, rect, cellColors[Cell(cell)])
+
+			switch Cell(cell) {
+			case UpCell:
+				drawTriangle(img, rect.Inset(size/5), true)
+			case DownCell:
+				drawTriangle(img, rect.Inset(size/5), false)
+			}
+		}
+	}
+}
+
+// drawTriangle draws an arrow head filling rect, pointing up or down.
+func drawTriangle(img *image.RGBA, rect image.Rectangle, up bool) {
+
+	height := rect.Dy()
+	for row := 0; row < height; row++ {
+
+		// Row width grows from the tip to the base
+		tip := row
+		if !up {
+			tip = height - 1 - row
+		}
+
+		half := rect.Dx() * (tip + 1) / (2 * height)
+		center := rect.Min.X + rect.Dx()/2
+		fill(img, image.Rect(center-half, rect.Min.Y+row, center+half, rect.Min.Y+row+1), cardBackground)
+	}
+}
+
+// fill paints rect of img with color c.
+func fill(img *image.RGBA, rect image.Rectangle, c color.Color) {
+	draw.Draw(img, rect, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// darken dims background pixels of rect to draw scanlines, leaving content pixels untouched.
+func darken(img *image.RGBA, rect image.Rectangle) {
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			if img.RGBAAt(x, y) == cardBackground {
+				img.SetRGBA(x, y, cardScanline)
+			}
+		}
+	}
+}
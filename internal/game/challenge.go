@@ -0,0 +1,24 @@
+package game
+
+// ChallengeLink represents a created challenge, to share with friends
+type ChallengeLink struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// ChallengeView represents a challenge as shown to a player, without its character
+type ChallengeView struct {
+	Token   string         `json:"token"`
+	Solved  bool           `json:"solved"`
+	Creator bool           `json:"creator"`
+	Solves  *int           `json:"solves,omitempty"` // Only shown to creator
+	Guesses []*GuessResult `json:"guesses,omitempty"`
+}
+
+// NewChallengeLink creates a challenge link from its token
+func NewChallengeLink(token string) *ChallengeLink {
+	return &ChallengeLink{
+		Token: token,
+		URL:   "/?challenge=" + token,
+	}
+}
@@ -0,0 +1,144 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/doruo/falloutdle/internal/character"
+)
+
+// Verdict represents how close a guessed attribute is from the answer
+type Verdict string
+
+const (
+	Correct   Verdict = "correct"   // Same value
+	Partial   Verdict = "partial"   // Some values in common
+	Incorrect Verdict = "incorrect" // Nothing in common
+)
+
+// Hint gives the chronological direction of the answer for dated attributes
+type Hint string
+
+const (
+	Earlier Hint = "earlier" // Answer is older than guess
+	Later   Hint = "later"   // Answer is newer than guess
+)
+
+// Compared attributes names
+const (
+	RaceAttribute        = "race"
+	GenderAttribute      = "gender"
+	StatusAttribute      = "status"
+	MainGameAttribute    = "main_game"
+	GamesAttribute       = "games"
+	AffiliationAttribute = "affiliation"
+	ActorsAttribute      = "actors"
+)
+
+// AttributeResult represents a guessed attribute compared with the answer one
+type AttributeResult struct {
+	Attribute string   `json:"attribute"`
+	Values    []string `json:"values"`
+	Verdict   Verdict  `json:"verdict"`
+	Hint      Hint     `json:"hint,omitempty"`
+}
+
+// GuessResult represents a guessed character compared with the answer
+type GuessResult struct {
+	Character  *character.View   `json:"character"`
+	Correct    bool              `json:"correct"`
+	Attributes []AttributeResult `json:"attributes"`
+}
+
+// CompareCharacters compares all attributes of guess character with answer character.
+func CompareCharacters(guess, answer *character.Character) *GuessResult {
+	return &GuessResult{
+		Character: guess.View(),
+		Correct:   guess.ID == answer.ID,
+		Attributes: []AttributeResult{
+			compareValue(RaceAttribute, guess.Race, answer.Race),
+			compareValue(GenderAttribute, guess.Gender, answer.Gender),
+			compareValue(StatusAttribute, guess.Status, answer.Status),
+			compareMainGame(guess.MainGame, answer.MainGame),
+			compareValues(GamesAttribute, guess.Games, answer.Games),
+			compareValues(AffiliationAttribute, guess.Affiliation, answer.Affiliation),
+			compareValues(ActorsAttribute, guess.Actors, answer.Actors),
+		},
+	}
+}
+
+// /----- UTILITY FUNCTIONS -----/
+
+// compareValue compares single value attributes, case insensitive.
+func compareValue(attribute, guess, answer string) AttributeResult {
+
+	result := AttributeResult{
+		Attribute: attribute,
+		Values:    []string{guess},
+		Verdict:   Incorrect,
+	}
+
+	if strings.EqualFold(guess, answer) {
+		result.Verdict = Correct
+	}
+
+	return result
+}
+
+// compareValues compares list attributes: all values in common is correct,
+// some values in common is partial.
+func compareValues(attribute string, guess, answer []string) AttributeResult {
+
+	result := AttributeResult{
+		Attribute: attribute,
+		Values:    guess,
+		Verdict:   Incorrect,
+	}
+
+	common := 0
+	for _, value := range guess {
+		if containsFold(answer, value) {
+			common++
+		}
+	}
+
+	switch {
+	case common == len(guess) && common == len(answer):
+		result.Verdict = Correct
+	case common > 0:
+		result.Verdict = Partial
+	}
+
+	return result
+}
+
+// compareMainGame compares main games, with a chronological hint when different.
+func compareMainGame(guess, answer string) AttributeResult {
+
+	result := compareValue(MainGameAttribute, guess, answer)
+	if result.Verdict == Correct {
+		return result
+	}
+
+	guessYear := character.GameCode(guess).ReleaseYear()
+	answerYear := character.GameCode(answer).ReleaseYear()
+
+	switch {
+	case guessYear == 0 || answerYear == 0:
+	case answerYear < guessYear:
+		result.Hint = Earlier
+	case answerYear > guessYear:
+		result.Hint = Later
+	}
+
+	return result
+}
+
+// containsFold determines if values contains value, case insensitive.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
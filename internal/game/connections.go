@@ -0,0 +1,503 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/character"
+)
+
+const (
+	ConnectionsGroups      = 4  // Hidden groups per puzzle
+	ConnectionsGroupSize   = 4  // Characters per group
+	ConnectionsMaxMistakes = 4  // Wrong submissions allowed before game over
+	connectionsAttempts    = 50 // Generation attempts before giving up
+)
+
+// Connections group kinds, attribute shared by a group
+const (
+	FactionGroup = "faction"
+	GameGroup    = "game"
+	RaceGroup    = "race"
+	TitleGroup   = "title"
+)
+
+var connectionsGroupKinds = []string{FactionGroup, GameGroup, RaceGroup, TitleGroup}
+
+// Title words too common to make a group
+var titleStopWords = map[string]bool{
+	"the": true, "of": true, "and": true, "from": true, "with": true, "mister": true,
+}
+
+// ConnectionsGroup represents a hidden group of characters sharing an attribute
+type ConnectionsGroup struct {
+	Kind         string `json:"kind"`
+	Value        string `json:"value"`
+	CharacterIDs []uint `json:"character_ids"`
+}
+
+// ConnectionsCard represents a character shown to the player
+type ConnectionsCard struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	ImageURL string `json:"image_url"`
+}
+
+// ConnectionsPuzzle represents a daily grouping puzzle
+type ConnectionsPuzzle struct {
+	Date   time.Time
+	Groups []ConnectionsGroup
+	Cards  []ConnectionsCard // Shuffled characters of all groups
+}
+
+// contains determines if group holds character ID.
+func (g *ConnectionsGroup) contains(id uint) bool {
+	for _, groupID := range g.CharacterIDs {
+		if groupID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// /----- GENERATION FUNCTIONS -----/
+
+// connectionsCategory represents a candidate group: an attribute and all characters sharing it
+type connectionsCategory struct {
+	kind    string
+	value   string
+	members map[uint]bool
+}
+
+// GenerateConnectionsPuzzle generates a puzzle from characters, with a unique solution.
+func GenerateConnectionsPuzzle(characters []character.Character, rng *rand.Rand) (*ConnectionsPuzzle, error) {
+
+	// Stable order so the same seed always gives the same puzzle
+	sorted := make([]character.Character, len(characters))
+	copy(sorted, characters)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	byID := make(map[uint]*character.Character, len(sorted))
+	for i := range sorted {
+		byID[sorted[i].ID] = &sorted[i]
+	}
+
+	categories := buildConnectionsCategories(sorted)
+
+	for attempt := 0; attempt < connectionsAttempts; attempt++ {
+
+		chosen := pickConnectionsCategories(categories, rng)
+		if chosen == nil {
+			break
+		}
+
+		puzzle, ok := fillConnectionsPuzzle(chosen, byID, rng)
+		if ok && puzzle.HasUniqueSolution(byID) {
+			return puzzle, nil
+		}
+	}
+
+	return nil, errors.New("not enough characters to generate connections puzzle")
+}
+
+// HasUniqueSolution verifies that every character matches exactly one group attribute,
+// so there is only one way to sort the characters into the groups.
+func (p *ConnectionsPuzzle) HasUniqueSolution(byID map[uint]*character.Character) bool {
+
+	seen := make(map[uint]bool)
+
+	for _, group := range p.Groups {
+
+		if len(group.CharacterIDs) != ConnectionsGroupSize {
+			return false
+		}
+
+		for _, id := range group.CharacterIDs {
+
+			char, exists := byID[id]
+			if !exists || seen[id] {
+				return false
+			}
+			seen[id] = true
+
+			matches := 0
+			for _, other := range p.Groups {
+				if categoryMatches(other.Kind, other.Value, char) {
+					matches++
+				}
+			}
+
+			if matches != 1 || !categoryMatches(group.Kind, group.Value, char) {
+				return false
+			}
+		}
+	}
+
+	return len(seen) == ConnectionsGroups*ConnectionsGroupSize
+}
+
+// buildConnectionsCategories lists all attributes shared by enough characters.
+func buildConnectionsCategories(characters []character.Character) []*connectionsCategory {
+
+	index := make(map[string]*connectionsCategory)
+	var keys []string
+
+	add := func(kind, value string, id uint) {
+		if value == "" {
+			return
+		}
+		key := kind + "|" + strings.ToLower(value)
+		category, exists := index[key]
+		if !exists {
+			category = &connectionsCategory{kind: kind, value: value, members: make(map[uint]bool)}
+			index[key] = category
+			keys = append(keys, key)
+		}
+		category.members[id] = true
+	}
+
+	for i := range characters {
+		char := &characters[i]
+
+		for _, affiliation := range char.Affiliation {
+			add(FactionGroup, affiliation, char.ID)
+		}
+		for _, game := range char.Games {
+			add(GameGroup, game, char.ID)
+		}
+		add(RaceGroup, char.Race, char.ID)
+		for _, keyword := range titleKeywords(char) {
+			add(TitleGroup, keyword, char.ID)
+		}
+	}
+
+	var categories []*connectionsCategory
+	for _, key := range keys {
+		if len(index[key].members) >= ConnectionsGroupSize {
+			categories = append(categories, index[key])
+		}
+	}
+
+	return categories
+}
+
+// pickConnectionsCategories picks random categories, one of each kind when possible.
+func pickConnectionsCategories(categories []*connectionsCategory, rng *rand.Rand) []*connectionsCategory {
+
+	if len(categories) < ConnectionsGroups {
+		return nil
+	}
+
+	shuffled := make([]*connectionsCategory, len(categories))
+	copy(shuffled, categories)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	var chosen []*connectionsCategory
+	picked := make(map[*connectionsCategory]bool)
+
+	// One category of each kind first, then any to complete
+	for _, kind := range connectionsGroupKinds {
+		for _, category := range shuffled {
+			if category.kind == kind {
+				chosen = append(chosen, category)
+				picked[category] = true
+				break
+			}
+		}
+	}
+	for _, category := range shuffled {
+		if len(chosen) >= ConnectionsGroups {
+			break
+		}
+		if !picked[category] {
+			chosen = append(chosen, category)
+		}
+	}
+
+	return chosen[:ConnectionsGroups]
+}
+
+// fillConnectionsPuzzle picks characters matching only their own category.
+func fillConnectionsPuzzle(chosen []*connectionsCategory, byID map[uint]*character.Character, rng *rand.Rand) (*ConnectionsPuzzle, bool) {
+
+	puzzle := &ConnectionsPuzzle{Date: time.Now()}
+
+	for _, category := range chosen {
+
+		var eligible []uint
+		for id := range category.members {
+
+			exclusive := true
+			for _, other := range chosen {
+				if other != category && categoryMatches(other.kind, other.value, byID[id]) {
+					exclusive = false
+					break
+				}
+			}
+			if exclusive {
+				eligible = append(eligible, id)
+			}
+		}
+
+		if len(eligible) < ConnectionsGroupSize {
+			return nil, false
+		}
+
+		sort.Slice(eligible, func(i, j int) bool { return eligible[i] < eligible[j] })
+		rng.Shuffle(len(eligible), func(i, j int) { eligible[i], eligible[j] = eligible[j], eligible[i] })
+
+		group := ConnectionsGroup{
+			Kind:         category.kind,
+			Value:        category.value,
+			CharacterIDs: eligible[:ConnectionsGroupSize],
+		}
+		puzzle.Groups = append(puzzle.Groups, group)
+
+		for _, id := range group.CharacterIDs {
+			char := byID[id]
+			puzzle.Cards = append(puzzle.Cards, ConnectionsCard{ID: char.ID, Name: char.Name, ImageURL: char.GetImageURL(0)})
+		}
+	}
+
+	rng.Shuffle(len(puzzle.Cards), func(i, j int) { puzzle.Cards[i], puzzle.Cards[j] = puzzle.Cards[j], puzzle.Cards[i] })
+
+	return puzzle, true
+}
+
+// categoryMatches determines if character shares the category attribute.
+func categoryMatches(kind, value string, c *character.Character) bool {
+	switch kind {
+	case FactionGroup:
+		return containsFold(c.Affiliation, value)
+	case GameGroup:
+		return containsFold(c.Games, value)
+	case RaceGroup:
+		return strings.EqualFold(c.Race, value)
+	case TitleGroup:
+		return containsFold(titleKeywords(c), value)
+	}
+	return false
+}
+
+// titleKeywords returns distinct meaningful words of character titles, lower case.
+func titleKeywords(c *character.Character) []string {
+
+	var keywords []string
+	seen := make(map[string]bool)
+
+	for _, title := range c.Titles {
+		for _, word := range strings.Fields(strings.ToLower(title)) {
+
+			word = strings.Trim(word, ".,;:!?'\"()")
+			if len(word) < 3 || titleStopWords[word] || seen[word] {
+				continue
+			}
+
+			seen[word] = true
+			keywords = append(keywords, word)
+		}
+	}
+
+	return keywords
+}
+
+// /----- PLAY FUNCTIONS -----/
+
+// ConnectionsProgress represents a player progress on a connections puzzle
+type ConnectionsProgress struct {
+	Found    []int // Found groups indexes
+	Mistakes int
+}
+
+// ConnectionsView represents a connections puzzle as shown to a player
+type ConnectionsView struct {
+	Cards       []ConnectionsCard  `json:"cards"`
+	Found       []ConnectionsGroup `json:"found"`
+	Mistakes    int                `json:"mistakes"`
+	MaxMistakes int                `json:"max_mistakes"`
+	Finished    bool               `json:"finished"`
+	Groups      []ConnectionsGroup `json:"groups,omitempty"` // Revealed once finished
+}
+
+// ConnectionsVerdict represents a submitted group verification result
+type ConnectionsVerdict struct {
+	Correct      bool               `json:"correct"`
+	OneAway      bool               `json:"one_away"`
+	Group        *ConnectionsGroup  `json:"group,omitempty"`
+	Mistakes     int                `json:"mistakes"`
+	MistakesLeft int                `json:"mistakes_left"`
+	Solved       bool               `json:"solved"`
+	Finished     bool               `json:"finished"`
+	Groups       []ConnectionsGroup `json:"groups,omitempty"` // Revealed once finished
+}
+
+// IsSolved determines if all groups have been found.
+func (cp *ConnectionsProgress) IsSolved() bool {
+	return len(cp.Found) == ConnectionsGroups
+}
+
+// IsFinished determines if puzzle is solved or lost.
+func (cp *ConnectionsProgress) IsFinished() bool {
+	return cp.IsSolved() || cp.Mistakes >= ConnectionsMaxMistakes
+}
+
+// isFound determines if group index has been found.
+func (cp *ConnectionsProgress) isFound(index int) bool {
+	for _, found := range cp.Found {
+		if found == index {
+			return true
+		}
+	}
+	return false
+}
+
+// View returns puzzle state as shown to the player of progress.
+func (p *ConnectionsPuzzle) View(progress *ConnectionsProgress) *ConnectionsView {
+
+	view := &ConnectionsView{
+		Cards:       p.Cards,
+		Found:       make([]ConnectionsGroup, 0),
+		Mistakes:    progress.Mistakes,
+		MaxMistakes: ConnectionsMaxMistakes,
+		Finished:    progress.IsFinished(),
+	}
+
+	for _, index := range progress.Found {
+		view.Found = append(view.Found, p.Groups[index])
+	}
+
+	if view.Finished {
+		view.Groups = p.Groups
+	}
+
+	return view
+}
+
+// Verify checks if submitted characters IDs form one of the hidden groups,
+// and updates player progress.
+func (p *ConnectionsPuzzle) Verify(progress *ConnectionsProgress, ids []uint) (*ConnectionsVerdict, error) {
+
+	if progress.IsFinished() {
+		return nil, ErrPuzzleFinished
+	}
+
+	if err := p.validateSubmission(progress, ids); err != nil {
+		return nil, err
+	}
+
+	verdict := &ConnectionsVerdict{}
+
+	for index := range p.Groups {
+
+		if progress.isFound(index) {
+			continue
+		}
+
+		group := &p.Groups[index]
+		common := 0
+		for _, id := range ids {
+			if group.contains(id) {
+				common++
+			}
+		}
+
+		if common == ConnectionsGroupSize {
+			verdict.Correct = true
+			verdict.Group = group
+			progress.Found = append(progress.Found, index)
+			break
+		}
+
+		if common == ConnectionsGroupSize-1 {
+			verdict.OneAway = true
+		}
+	}
+
+	if !verdict.Correct {
+		progress.Mistakes++
+	}
+
+	verdict.Mistakes = progress.Mistakes
+	verdict.MistakesLeft = ConnectionsMaxMistakes - progress.Mistakes
+	verdict.Solved = progress.IsSolved()
+	verdict.Finished = progress.IsFinished()
+
+	if verdict.Finished {
+		verdict.Groups = p.Groups
+	}
+
+	return verdict, nil
+}
+
+// Replay rebuilds player progress from his saved submissions.
+func (p *ConnectionsPuzzle) Replay(submissions []string) *ConnectionsProgress {
+
+	progress := &ConnectionsProgress{}
+	for _, submission := range submissions {
+		p.Verify(progress, DecodeSubmission(submission))
+	}
+
+	return progress
+}
+
+// EncodeSubmission returns submitted IDs as a saved guess, Rx: "1,2,3,4".
+func EncodeSubmission(ids []uint) string {
+
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(uint64(id), 10)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// DecodeSubmission returns submitted IDs from a saved guess, as opposite to EncodeSubmission.
+func DecodeSubmission(submission string) []uint {
+
+	var ids []uint
+	for _, part := range strings.Split(submission, ",") {
+		if id, err := strconv.ParseUint(part, 10, 64); err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+
+	return ids
+}
+
+// validateSubmission verifies submitted IDs are distinct puzzle characters not already grouped.
+func (p *ConnectionsPuzzle) validateSubmission(progress *ConnectionsProgress, ids []uint) error {
+
+	if len(ids) != ConnectionsGroupSize {
+		return fmt.Errorf("%w: expected %d characters, got %d", ErrInvalidSubmission, ConnectionsGroupSize, len(ids))
+	}
+
+	seen := make(map[uint]bool)
+	for _, id := range ids {
+
+		if seen[id] {
+			return fmt.Errorf("%w: duplicated character %d", ErrInvalidSubmission, id)
+		}
+		seen[id] = true
+
+		inPuzzle := false
+		for index, group := range p.Groups {
+			if group.contains(id) {
+				if progress.isFound(index) {
+					return fmt.Errorf("%w: character %d already grouped", ErrInvalidSubmission, id)
+				}
+				inPuzzle = true
+			}
+		}
+
+		if !inPuzzle {
+			return fmt.Errorf("%w: character %d not in puzzle", ErrInvalidSubmission, id)
+		}
+	}
+
+	return nil
+}
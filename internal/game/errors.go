@@ -0,0 +1,11 @@
+package game
+
+import "errors"
+
+var (
+	ErrPuzzleFinished    = errors.New("puzzle already finished")
+	ErrInvalidSubmission = errors.New("invalid submission")
+	ErrInvalidMode       = errors.New("invalid game mode")
+	ErrPuzzleNotFinished = errors.New("puzzle not finished yet")
+	ErrInvalidGuess      = errors.New("invalid guess")
+)
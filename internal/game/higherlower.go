@@ -0,0 +1,196 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/character"
+)
+
+const (
+	higherLowerPickAttempts = 20        // Attempts to find a character without a tie
+	higherLowerRunTimeout   = time.Hour // Runs not played for longer are abandoned
+)
+
+// Facet represents a numeric character attribute compared in higher or lower mode
+type Facet string
+
+const (
+	GamesFacet        Facet = "games"        // Number of game appearances
+	TitlesFacet       Facet = "titles"       // Number of titles
+	AffiliationsFacet Facet = "affiliations" // Number of affiliations
+	ReleaseYearFacet  Facet = "release_year" // Earliest game release year
+)
+
+// AllFacets is the full list of higher or lower facets
+var AllFacets = []Facet{GamesFacet, TitlesFacet, AffiliationsFacet, ReleaseYearFacet}
+
+// Higher or lower choices, character picked as having more of the facet
+const (
+	ChoiceA = "a"
+	ChoiceB = "b"
+)
+
+// Value returns character facet value, 0 if unknown.
+func (f Facet) Value(c *character.Character) int {
+	switch f {
+	case GamesFacet:
+		return len(c.Games)
+	case TitlesFacet:
+		return len(c.Titles)
+	case AffiliationsFacet:
+		return len(c.Affiliation)
+	case ReleaseYearFacet:
+		earliest := 0
+		for _, game := range c.Games {
+			year := character.GameCode(game).ReleaseYear()
+			if year > 0 && (earliest == 0 || year < earliest) {
+				earliest = year
+			}
+		}
+		return earliest
+	}
+	return 0
+}
+
+// HigherLowerCard represents a character shown in a round
+type HigherLowerCard struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	ImageURL string `json:"image_url"`
+	Value    *int   `json:"value,omitempty"` // Hidden until answered
+}
+
+// HigherLowerRound represents two characters to compare on a facet
+type HigherLowerRound struct {
+	Facet Facet
+	A     character.Character
+	B     character.Character
+}
+
+// HigherLowerRun represents a player endless streak
+type HigherLowerRun struct {
+	Round     *HigherLowerRound
+	Streak    int
+	Over      bool
+	StartedAt time.Time
+	PlayedAt  time.Time // Last round start
+}
+
+// HigherLowerView represents a run as shown to a player
+type HigherLowerView struct {
+	Facet  Facet           `json:"facet"`
+	A      HigherLowerCard `json:"a"`
+	B      HigherLowerCard `json:"b"`
+	Streak int             `json:"streak"`
+	Best   int             `json:"best"`
+}
+
+// HigherLowerResult represents an answered round result
+type HigherLowerResult struct {
+	Correct bool             `json:"correct"`
+	A       HigherLowerCard  `json:"a"`
+	B       HigherLowerCard  `json:"b"`
+	Streak  int              `json:"streak"`
+	Best    int              `json:"best"`
+	Over    bool             `json:"over"`
+	Next    *HigherLowerView `json:"next,omitempty"`
+}
+
+// NewHigherLowerRound picks a facet and two characters with different values of it.
+// Keeps first character when given, as the previous round winner.
+func NewHigherLowerRound(characters []character.Character, first *character.Character, rng *rand.Rand) (*HigherLowerRound, error) {
+
+	if len(characters) < 2 {
+		return nil, errors.New("not enough characters for higher or lower round")
+	}
+
+	for attempt := 0; attempt < higherLowerPickAttempts; attempt++ {
+
+		round := &HigherLowerRound{Facet: AllFacets[rng.Intn(len(AllFacets))]}
+
+		if first != nil {
+			round.A = *first
+		} else {
+			round.A = characters[rng.Intn(len(characters))]
+		}
+		round.B = characters[rng.Intn(len(characters))]
+
+		valueA, valueB := round.Facet.Value(&round.A), round.Facet.Value(&round.B)
+
+		// Unknown release year can not be compared
+		if round.Facet == ReleaseYearFacet && (valueA == 0 || valueB == 0) {
+			continue
+		}
+
+		if round.A.ID != round.B.ID && valueA != valueB {
+			return round, nil
+		}
+	}
+
+	return nil, errors.New("no characters without a tie for higher or lower round")
+}
+
+// NewHigherLowerRun creates a run starting with round at now.
+func NewHigherLowerRun(round *HigherLowerRound, now time.Time) *HigherLowerRun {
+	return &HigherLowerRun{Round: round, StartedAt: now, PlayedAt: now}
+}
+
+// IsStale determines if run can be dropped: over, abandoned, or started on a previous day.
+func (r *HigherLowerRun) IsStale(now time.Time) bool {
+	return r.Over || now.Sub(r.PlayedAt) > higherLowerRunTimeout || r.StartedAt.Before(now.UTC().Truncate(24*time.Hour))
+}
+
+// dropStaleHigherLowerRuns removes stale runs from runs by player.
+func dropStaleHigherLowerRuns(runs map[uint]*HigherLowerRun, now time.Time) {
+	for playerID, run := range runs {
+		if run.IsStale(now) {
+			delete(runs, playerID)
+		}
+	}
+}
+
+// Winner returns the character having more of the round facet.
+func (r *HigherLowerRound) Winner() *character.Character {
+	if r.Facet.Value(&r.A) > r.Facet.Value(&r.B) {
+		return &r.A
+	}
+	return &r.B
+}
+
+// Answer checks if choice is the character having more of the round facet.
+func (r *HigherLowerRound) Answer(choice string) (bool, error) {
+
+	switch choice {
+	case ChoiceA:
+		return r.Winner().ID == r.A.ID, nil
+	case ChoiceB:
+		return r.Winner().ID == r.B.ID, nil
+	}
+
+	return false, ErrInvalidSubmission
+}
+
+// View returns round as shown to a player, only first character value is revealed.
+func (r *HigherLowerRound) View(streak, best int) *HigherLowerView {
+	return &HigherLowerView{
+		Facet:  r.Facet,
+		A:      newHigherLowerCard(&r.A, r.Facet, true),
+		B:      newHigherLowerCard(&r.B, r.Facet, false),
+		Streak: streak,
+		Best:   best,
+	}
+}
+
+// newHigherLowerCard creates a card from character, with facet value if revealed.
+func newHigherLowerCard(c *character.Character, facet Facet, revealed bool) HigherLowerCard {
+
+	card := HigherLowerCard{ID: c.ID, Name: c.Name, ImageURL: c.GetImageURL(0)}
+	if revealed {
+		value := facet.Value(c)
+		card.Value = &value
+	}
+
+	return card
+}
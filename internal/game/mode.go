@@ -0,0 +1,69 @@
+package game
+
+import "github.com/doruo/falloutdle/internal/character"
+
+// Mode represents a game mode
+type Mode string
+
+const (
+	ClassicMode     Mode = "classic"     // Guess character from compared attributes
+	ActorMode       Mode = "actor"       // Guess character from voice actors clue
+	SpellingMode    Mode = "spelling"    // Guess character name letters
+	ConnectionsMode Mode = "connections" // Sort characters into hidden groups
+	HigherLowerMode Mode = "higherlower" // Endless streak comparing characters facets
+)
+
+// AllModes is the full list of game modes
+var AllModes = []Mode{
+	ClassicMode,
+	ActorMode,
+	SpellingMode,
+	ConnectionsMode,
+	HigherLowerMode,
+}
+
+// Modes display names
+var modeTitles = map[Mode]string{
+	ClassicMode:     "Classic",
+	ActorMode:       "Actor",
+	SpellingMode:    "Spelling",
+	ConnectionsMode: "Connections",
+	HigherLowerMode: "Higher or Lower",
+}
+
+// Title returns mode display name
+func (m Mode) Title() string {
+	return modeTitles[m]
+}
+
+// IsValid determines if mode is a known game mode
+func (m Mode) IsValid() bool {
+	for _, mode := range AllModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDailyCharacter determines if mode is about guessing a daily character
+func (m Mode) HasDailyCharacter() bool {
+	return m == ClassicMode || m == ActorMode || m == SpellingMode
+}
+
+// HasDailyPuzzle determines if mode has a daily puzzle, solved in a number of guesses
+func (m Mode) HasDailyPuzzle() bool {
+	return m.HasDailyCharacter() || m == ConnectionsMode
+}
+
+// accepts determines if a character can be picked as answer for this mode
+func (m Mode) accepts(c *character.Character) bool {
+	switch m {
+	case ActorMode:
+		return len(c.Actors) > 0
+	case SpellingMode:
+		return len([]rune(SpellingName(c))) >= spellingMinLength
+	default:
+		return true
+	}
+}
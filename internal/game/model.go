@@ -8,13 +8,21 @@ import (
 
 // Game represents a current game state
 type Game struct {
+	Mode             Mode `json:"mode"`
 	CurrentCharacter character.Character
 	Date             time.Time `json:"date"`
 }
 
-func NewGame(c character.Character) *Game {
+func NewGame(mode Mode, c character.Character) *Game {
 	return &Game{
+		Mode:             mode,
 		CurrentCharacter: c,
 		Date:             time.Now(),
 	}
 }
+
+// IsToday determines if game has been created today (UTC)
+func (g *Game) IsToday() bool {
+	day := 24 * time.Hour
+	return g.Date.UTC().Truncate(day).Equal(time.Now().UTC().Truncate(day))
+}
@@ -0,0 +1,99 @@
+package game
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/pkg/random"
+)
+
+// Launch day of the first daily puzzle, numbered 1
+var launchDate = time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+// PuzzleNumber returns the daily puzzle number at date, from its UTC day
+func PuzzleNumber(date time.Time) int {
+	day := 24 * time.Hour
+	return int(date.UTC().Truncate(day).Sub(launchDate)/day) + 1
+}
+
+// PuzzleDate returns the UTC day of daily puzzle number
+func PuzzleDate(number int) time.Time {
+	return launchDate.AddDate(0, 0, number-1)
+}
+
+// WeekNumbers returns first and last daily puzzle numbers of date week, from Monday to Sunday
+func WeekNumbers(date time.Time) (int, int) {
+	sinceMonday := (int(date.UTC().Weekday()) + 6) % 7
+	first := PuzzleNumber(date) - sinceMonday
+	return first, first + 6
+}
+
+// PuzzleKey returns the key identifying the daily puzzle of mode at date
+func PuzzleKey(mode Mode, date time.Time) string {
+	return fmt.Sprintf("%s:%s", mode, date.UTC().Format(time.DateOnly))
+}
+
+// PickDailyCharacter returns the daily character of mode at date among candidates, the same whatever
+// candidates order. Daily character modes are picked in order from a date seed, each never picking
+// a previous mode character, so that a date answers never change even after a restart.
+func PickDailyCharacter(candidates []character.Character, mode Mode, date time.Time) (*character.Character, error) {
+
+	candidates = slices.Clone(candidates)
+	slices.SortFunc(candidates, func(a, b character.Character) int { return cmp.Compare(a.ID, b.ID) })
+
+	picked := make(map[uint]bool)
+
+	for i, m := range AllModes {
+
+		if !m.HasDailyCharacter() {
+			continue
+		}
+
+		var accepted []character.Character
+		for _, c := range candidates {
+			if m.accepts(&c) && !picked[c.ID] {
+				accepted = append(accepted, c)
+			}
+		}
+
+		if len(accepted) == 0 {
+			return nil, fmt.Errorf("no characters available for %s mode", m)
+		}
+
+		rng := random.NewSeededRandom(random.DailySeed(date)*int64(len(AllModes)) + int64(i))
+		answer := accepted[rng.Intn(len(accepted))]
+
+		if m == mode {
+			return &answer, nil
+		}
+		picked[answer.ID] = true
+	}
+
+	return nil, fmt.Errorf("no daily character for game mode: %s", mode)
+}
+
+// ChallengeKey returns the key identifying a challenge puzzle
+func ChallengeKey(challengeID uint) string {
+	return fmt.Sprintf("challenge:%d", challengeID)
+}
+
+// GuessesView represents a player guesses on a puzzle
+type GuessesView struct {
+	Mode    Mode           `json:"mode"`
+	Guesses []*GuessResult `json:"guesses"`
+	Solved  bool           `json:"solved"`
+}
+
+// PuzzleView represents today puzzle of a mode as shown to a player, its answer only revealed once finished
+type PuzzleView struct {
+	Mode     Mode            `json:"mode"`
+	Number   int             `json:"number"`
+	Date     time.Time       `json:"date"`
+	Guesses  int             `json:"guesses"` // Player guesses so far
+	Finished bool            `json:"finished"`
+	Won      bool            `json:"won"`
+	Answer   *character.View `json:"answer,omitempty"` // Daily character, once player finished
+}
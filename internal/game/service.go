@@ -1,16 +1,43 @@
 package game
 
 import (
+	"errors"
 	"fmt"
+	"image"
+	"strconv"
+	"sync"
 
+	"github.com/doruo/falloutdle/internal/achievement"
+	"github.com/doruo/falloutdle/internal/challenge"
 	"github.com/doruo/falloutdle/internal/character"
 	"github.com/doruo/falloutdle/internal/database"
+	"github.com/doruo/falloutdle/internal/leaderboard"
+	"github.com/doruo/falloutdle/internal/league"
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/internal/score"
+	"github.com/doruo/falloutdle/internal/stats"
+	"github.com/doruo/falloutdle/pkg/random"
+	"github.com/doruo/falloutdle/pkg/strings"
+	"github.com/doruo/falloutdle/pkg/time"
 )
 
 // Game logic service
 type GameService struct {
-	characterService character.Service
-	currentGame      *Game
+	mutex              sync.Mutex
+	characterService   character.Service
+	playerService      *player.Service
+	scoreService       *score.Service
+	challengeService   *challenge.Service
+	statsService       *stats.Service
+	boardService       *leaderboard.Service
+	leagueService      *league.Service
+	achievementService *achievement.Service
+	resultSigner       *stats.ResultSigner
+	currentGames       map[Mode]*Game
+
+	connections      *ConnectionsPuzzle
+	higherLowerMutex sync.Mutex               // Guards higherLowerRuns only
+	higherLowerRuns  map[uint]*HigherLowerRun // Run by player
 }
 
 var instance *GameService
@@ -21,8 +48,18 @@ func NewGameService() *GameService {
 	repo := character.NewCharacterRepository(db)
 
 	return &GameService{
-		characterService: *character.NewCharacterService(repo),
-		currentGame:      nil,
+		characterService:   *character.NewCharacterService(repo),
+		playerService:      player.NewPlayerService(player.NewPlayerRepository(db), player.NewDefaultCookieSigner()),
+		scoreService:       score.NewScoreService(score.NewScoreRepository(db)),
+		challengeService:   challenge.NewChallengeService(challenge.NewChallengeRepository(db), challenge.NewDefaultTokener()),
+		statsService:       stats.NewStatsService(stats.NewStatsRepository(db)),
+		boardService:       leaderboard.NewLeaderboardService(leaderboard.NewLeaderboardRepository(db)),
+		leagueService:      league.NewLeagueService(league.NewLeagueRepository(db)),
+		achievementService: achievement.NewAchievementService(achievement.NewAchievementRepository(db)),
+		resultSigner:       stats.NewDefaultResultSigner(),
+		currentGames:       make(map[Mode]*Game),
+
+		higherLowerRuns: make(map[uint]*HigherLowerRun),
 	}
 }
 
@@ -33,26 +70,24 @@ func GetServiceInstance() *GameService {
 	return instance
 }
 
-// NewCurrentGame creates a new game for today from a RandomCharacter
-func (gs *GameService) NewCurrentGame() (*Game, error) {
-
-	// Retrieves random character from database
-	character, error := gs.getRandomValidCharacter()
-
-	// Retrieves another character if not valid
-	for !gs.characterService.IsValidForGame(character) {
+// NewCurrentGame creates a new game for today from today character of mode,
+// the same for the whole day even after a restart
+func (gs *GameService) NewCurrentGame(mode Mode) (*Game, error) {
 
-		if error != nil {
-			return nil, error
-		}
+	candidates, err := gs.characterService.GetDailyCandidates(time.Today())
+	if err != nil {
+		return nil, err
+	}
 
-		character, error = gs.getRandomValidCharacter()
+	character, err := PickDailyCharacter(candidates, mode, time.Today())
+	if err != nil {
+		return nil, err
 	}
 
 	// Marks character or update played date
 	gs.characterService.UpdateAsPlayed(character.ID)
 
-	return NewGame(*character), nil
+	return NewGame(mode, *character), nil
 }
 
 // /----- GET LOGIC FUNCTIONS -----/
@@ -69,58 +104,970 @@ func (gs *GameService) GetRandomCharacter() (*character.Character, error) {
 	return character, nil
 }
 
-func (gs *GameService) getRandomValidCharacter() (*character.Character, error) {
+// GetCharacter returns a character as shown publicly, from its ID.
+func (gs *GameService) GetCharacter(id uint) (*character.View, error) {
 
-	// Retrieves random character from database
-	character, error := gs.GetRandomCharacter()
+	char, err := gs.characterService.GetByID(int(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return char.View(), nil
+}
 
-	// Retrieves another character if not valid
-	for !gs.characterService.IsValidForGame(character) {
+// GetCharacters returns characters as shown publicly, from their IDs in order. Unknown IDs are left out.
+func (gs *GameService) GetCharacters(ids []uint) ([]*character.View, error) {
 
-		if error != nil {
-			return nil, error
-		}
-		character, error = gs.GetRandomCharacter()
+	characters, err := gs.characterService.GetByIDs(ids)
+	if err != nil {
+		return nil, err
 	}
 
-	return character, nil
+	views := make([]*character.View, 0, len(characters))
+	for i := range characters {
+		views = append(views, characters[i].View())
+	}
+
+	return views, nil
+}
+
+// GetCharacterByTitle returns a character as shown publicly, from its wiki page title.
+// Title words may be separated by underscores as in wiki URLs.
+func (gs *GameService) GetCharacterByTitle(title string) (*character.View, error) {
+
+	char, err := gs.characterService.GetByWikiTitle(title)
+	if errors.Is(err, character.ErrCharacterNotFound) {
+		char, err = gs.characterService.GetByWikiTitle(strings.UnnormalizeString(title))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return char.View(), nil
 }
 
-// GetCurrentCharacter returns today current character.
-// Creates a new one if none found
-func (gs *GameService) GetCurrentCharacter() (*character.Character, error) {
+// CompareAny compares two arbitrary characters, from their IDs or names,
+// exactly as guessed character would be compared with answer.
+func (gs *GameService) CompareAny(guess, answer string) (*GuessResult, error) {
 
-	game, err := gs.getCurrentGame()
+	guessed, err := gs.findCharacter(guess)
+	if err != nil {
+		return nil, err
+	}
+
+	answered, err := gs.findCharacter(answer)
+	if err != nil {
+		return nil, err
+	}
+
+	return CompareCharacters(guessed, answered), nil
+}
+
+// findCharacter retrieves a character from its ID, or resolves it from its name.
+func (gs *GameService) findCharacter(value string) (*character.Character, error) {
+
+	if id, err := strconv.ParseUint(value, 10, 0); err == nil {
+		return gs.characterService.GetByID(int(id))
+	}
+
+	return gs.characterService.Resolve(value)
+}
+
+// SearchCharacters returns characters best matching query, for names autocompletion.
+func (gs *GameService) SearchCharacters(query string, limit int) ([]character.SearchResult, error) {
+	return gs.characterService.Search(query, limit)
+}
+
+// ListCharacters returns a page of characters matching filter. Listed characters hold
+// no played date and every character is listed, so today answer can not be deduced.
+func (gs *GameService) ListCharacters(filter character.ListFilter, sort character.Sort, cursor string, limit int) (*character.Page, error) {
+	return gs.characterService.List(filter, sort, cursor, limit)
+}
+
+// GetActorClue returns today actor mode clue: voice actors of the character
+// and their other roles in the franchise.
+func (gs *GameService) GetActorClue() (*ActorClue, error) {
+
+	game, err := gs.getCurrentGame(ActorMode)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &game.CurrentCharacter, nil
+	answer := &game.CurrentCharacter
+	clue := NewActorClue(answer.Actors)
+
+	for _, actor := range answer.Actors {
+
+		roles, err := gs.characterService.GetByActor(actor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, role := range roles {
+			// Never reveal the answer itself
+			if role.ID != answer.ID {
+				clue.AddRole(actor, &role)
+			}
+		}
+	}
+
+	return clue, nil
 }
 
-// GetCurrentGame returns today current game.
-// Creates a new one for if none found
-func (gs *GameService) getCurrentGame() (*Game, error) {
+// getCurrentGame returns today current game for mode.
+// Creates a new one for today if none found
+func (gs *GameService) getCurrentGame(mode Mode) (*Game, error) {
+
+	if !mode.HasDailyCharacter() {
+		return nil, fmt.Errorf("no daily character for game mode: %s", mode)
+	}
+
+	gs.mutex.Lock()
+	defer gs.mutex.Unlock()
 
 	// Creates a new one for today if none found
-	if gs.currentGame == nil {
+	game := gs.currentGames[mode]
+	if game == nil || !game.IsToday() {
 
-		fmt.Println("LOG: no game found for today, creating new one...")
+		fmt.Println("LOG: no", mode, "game found for today, creating new one...")
 
 		var err error
-		gs.currentGame, err = gs.NewCurrentGame()
+		game, err = gs.NewCurrentGame(mode)
 
 		if err != nil {
 			return nil, err
 		}
+		gs.currentGames[mode] = game
 	}
 
-	return gs.currentGame, nil
+	return game, nil
 }
 
-// /----- POST LOGIC FUNCTIONS -----/
+// GetGuesses returns player saved guesses on today puzzle of mode.
+func (gs *GameService) GetGuesses(playerID uint, mode Mode) (*GuessesView, error) {
+
+	game, err := gs.getCurrentGame(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(mode, game.Date))
+	if err != nil {
+		return nil, err
+	}
+
+	guesses, err := gs.replayGuesses(progress.Guesses, &game.CurrentCharacter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GuessesView{
+		Mode:    mode,
+		Guesses: guesses,
+		Solved:  progress.Finished,
+	}, nil
+}
+
+// replayGuesses compares again saved guessed characters with answer.
+func (gs *GameService) replayGuesses(saved []string, answer *character.Character) ([]*GuessResult, error) {
+
+	guesses := make([]*GuessResult, 0, len(saved))
+
+	for _, entry := range saved {
+
+		guess, err := gs.getGuessedCharacter(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		guesses = append(guesses, CompareCharacters(guess, answer))
+	}
+
+	return guesses, nil
+}
+
+// getGuessedCharacter returns the character of a saved guess: its ID,
+// or its name in progress saved before, as names are not unique.
+func (gs *GameService) getGuessedCharacter(entry string) (*character.Character, error) {
+
+	id, err := strconv.ParseUint(entry, 10, 0)
+	if err != nil {
+		return gs.characterService.GetByName(entry)
+	}
+
+	characters, err := gs.characterService.GetByIDs([]uint{uint(id)})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(characters) == 0 {
+		return nil, fmt.Errorf("failed to get guessed character ID %d: %w", id, character.ErrCharacterNotFound)
+	}
+
+	return &characters[0], nil
+}
+
+// guessEntry returns guessed character as saved in progress.
+func guessEntry(guess *character.Character) string {
+	return strconv.FormatUint(uint64(guess.ID), 10)
+}
+
+// GetConnections returns today connections puzzle as shown to player.
+func (gs *GameService) GetConnections(playerID uint) (*ConnectionsView, error) {
+
+	puzzle, err := gs.getCurrentConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(ConnectionsMode, puzzle.Date))
+	if err != nil {
+		return nil, err
+	}
+
+	return puzzle.View(puzzle.Replay(progress.Guesses)), nil
+}
+
+// getCurrentConnections returns today connections puzzle.
+// Generates a new one for today if none found.
+func (gs *GameService) getCurrentConnections() (*ConnectionsPuzzle, error) {
+
+	gs.mutex.Lock()
+	defer gs.mutex.Unlock()
+
+	today := time.Today()
+	if gs.connections != nil && !gs.connections.Date.Before(today) {
+		return gs.connections, nil
+	}
+
+	fmt.Println("LOG: no connections puzzle found for today, generating new one...")
+
+	characters, err := gs.characterService.GetDailyCandidates(today)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same puzzle for the whole day, even after a restart
+	puzzle, err := GenerateConnectionsPuzzle(characters, random.NewSeededRandom(random.DailySeed(today)))
+	if err != nil {
+		return nil, err
+	}
 
-func (gs *GameService) ProcessGuess(string) {
+	gs.connections = puzzle
+
+	return puzzle, nil
+}
+
+// GetSpelling returns today spelling puzzle as shown to player.
+func (gs *GameService) GetSpelling(playerID uint) (*SpellingView, error) {
+
+	game, err := gs.getCurrentGame(SpellingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(SpellingMode, game.Date))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSpellingProgress(game, progress.Guesses).View(), nil
+}
+
+// GetHigherLower returns player current higher or lower round.
+// Starts a new run if none found or previous one is over, abandoned or from a previous day.
+func (gs *GameService) GetHigherLower(playerID uint) (*HigherLowerView, error) {
+
+	_, state := gs.getHigherLowerRun(playerID)
+	if state == nil {
+
+		// Round is made out of lock, from the whole characters index
+		round, err := gs.newHigherLowerRound(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		state = gs.startHigherLowerRun(playerID, round)
+	}
+
+	best := gs.scoreService.GetBest(playerID, string(HigherLowerMode))
+
+	return state.Round.View(state.Streak, best), nil
+}
+
+// getHigherLowerRun returns player current run with a copy of its state, nil if none or stale.
+func (gs *GameService) getHigherLowerRun(playerID uint) (*HigherLowerRun, *HigherLowerRun) {
+
+	gs.higherLowerMutex.Lock()
+	defer gs.higherLowerMutex.Unlock()
+
+	run := gs.higherLowerRuns[playerID]
+	if run == nil || run.IsStale(time.Now()) {
+		return nil, nil
+	}
+
+	state := *run
+	return run, &state
+}
+
+// startHigherLowerRun starts player run with round and returns a copy of its state,
+// unless another one was started meanwhile. Stale runs of every player are dropped.
+func (gs *GameService) startHigherLowerRun(playerID uint, round *HigherLowerRound) *HigherLowerRun {
+
+	gs.higherLowerMutex.Lock()
+	defer gs.higherLowerMutex.Unlock()
+
+	now := time.Now()
+	dropStaleHigherLowerRuns(gs.higherLowerRuns, now)
+
+	run := gs.higherLowerRuns[playerID]
+	if run == nil {
+		run = NewHigherLowerRun(round, now)
+		gs.higherLowerRuns[playerID] = run
+	}
+
+	state := *run
+	return &state
+}
+
+// newHigherLowerRound creates a new higher or lower round from valid characters.
+func (gs *GameService) newHigherLowerRound(first *character.Character) (*HigherLowerRound, error) {
+
+	characters, err := gs.characterService.GetAllValidCharacters()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHigherLowerRound(characters, first, random.NewRandom())
+}
+
+// GetChallenge returns challenge from token as shown to player, with his guesses.
+func (gs *GameService) GetChallenge(playerID uint, token string) (*ChallengeView, error) {
+
+	c, err := gs.challengeService.GetByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := gs.characterService.GetByID(int(c.CharacterID))
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, ChallengeKey(c.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	guesses, err := gs.replayGuesses(progress.Guesses, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &ChallengeView{
+		Token:   token,
+		Solved:  gs.challengeService.HasSolved(c, playerID),
+		Creator: c.IsCreator(playerID),
+		Guesses: guesses,
+	}
+
+	if view.Creator {
+		view.Solves = &c.Solves
+	}
+
+	return view, nil
+}
+
+// GetChallengeStats returns challenge from token with its solves count, only for its creator.
+func (gs *GameService) GetChallengeStats(playerID uint, token string) (*ChallengeView, error) {
+
+	c, err := gs.challengeService.GetStats(token, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChallengeView{
+		Token:   token,
+		Solved:  gs.challengeService.HasSolved(c, playerID),
+		Creator: true,
+		Solves:  &c.Solves,
+	}, nil
+}
+
+// GetStats returns player statistics in all played modes.
+func (gs *GameService) GetStats(playerID uint) ([]stats.StatView, error) {
+	return gs.statsService.GetStats(playerID, PuzzleNumber(time.Today()))
+}
+
+// GetShare returns player finished today puzzle of mode as spoiler-free text,
+// with the URL of its share card image.
+func (gs *GameService) GetShare(playerID uint, mode Mode) (*Share, error) {
+
+	share, err := gs.getShare(playerID, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Card is only available once result is recorded
+	result, err := gs.statsService.GetPuzzleResult(playerID, PuzzleKey(mode, PuzzleDate(share.Number)))
+	if err == nil {
+		share.CardURL = fmt.Sprintf("/api/v1/cards/%s.png", gs.resultSigner.Sign(result.ID))
+	}
+
+	return share, nil
+}
+
+// GetCard returns share card image of the result signed in token.
+func (gs *GameService) GetCard(token string) (*image.RGBA, error) {
+
+	id, err := gs.resultSigner.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := gs.statsService.GetResult(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return RenderCard(result), nil
+}
+
+// getShare returns player finished today puzzle of mode as spoiler-free text.
+func (gs *GameService) getShare(playerID uint, mode Mode) (*Share, error) {
+
+	switch mode {
+
+	case ClassicMode, ActorMode:
+		game, err := gs.getCurrentGame(mode)
+		if err != nil {
+			return nil, err
+		}
+
+		progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(mode, game.Date))
+		if err != nil {
+			return nil, err
+		}
+		if !progress.Finished {
+			return nil, ErrPuzzleNotFinished
+		}
+
+		guesses, err := gs.replayGuesses(progress.Guesses, &game.CurrentCharacter)
+		if err != nil {
+			return nil, err
+		}
+
+		return ShareGuesses(mode, PuzzleNumber(game.Date), guesses), nil
+
+	case SpellingMode:
+		game, err := gs.getCurrentGame(SpellingMode)
+		if err != nil {
+			return nil, err
+		}
+
+		progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(SpellingMode, game.Date))
+		if err != nil {
+			return nil, err
+		}
+		spelling := NewSpellingProgress(game, progress.Guesses)
+		if !spelling.IsFinished() {
+			return nil, ErrPuzzleNotFinished
+		}
+
+		return ShareSpelling(PuzzleNumber(game.Date), spelling), nil
+
+	case ConnectionsMode:
+		puzzle, err := gs.getCurrentConnections()
+		if err != nil {
+			return nil, err
+		}
+
+		progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(ConnectionsMode, puzzle.Date))
+		if err != nil {
+			return nil, err
+		}
+		if !puzzle.Replay(progress.Guesses).IsFinished() {
+			return nil, ErrPuzzleNotFinished
+		}
+
+		return puzzle.Share(PuzzleNumber(puzzle.Date), progress.Guesses), nil
+	}
+
+	return nil, ErrInvalidMode
+}
+
+// GetPuzzle returns today puzzle of mode as shown to player, with its answer once he finished it.
+func (gs *GameService) GetPuzzle(playerID uint, mode Mode) (*PuzzleView, error) {
+
+	if !mode.HasDailyPuzzle() {
+		return nil, ErrInvalidMode
+	}
+
+	var number int
+	var answer *character.Character
+
+	if mode == ConnectionsMode {
+		puzzle, err := gs.getCurrentConnections()
+		if err != nil {
+			return nil, err
+		}
+		number = PuzzleNumber(puzzle.Date)
+	} else {
+		game, err := gs.getCurrentGame(mode)
+		if err != nil {
+			return nil, err
+		}
+		number, answer = PuzzleNumber(game.Date), &game.CurrentCharacter
+	}
+
+	key := PuzzleKey(mode, PuzzleDate(number))
+	view := &PuzzleView{Mode: mode, Number: number, Date: PuzzleDate(number)}
+
+	progress, err := gs.playerService.GetProgress(playerID, key)
+	if err != nil {
+		return nil, err
+	}
+	view.Guesses = len(progress.Guesses)
+
+	// Finished puzzles have a result, whatever the mode
+	result, err := gs.statsService.GetPuzzleResult(playerID, key)
+	if err != nil {
+		return view, nil
+	}
+
+	view.Finished, view.Won = true, result.Won
+	if answer != nil {
+		view.Answer = answer.View()
+	}
+
+	return view, nil
+}
+
+// GetLeaderboard returns players ranking of period in mode, today puzzle for daily period.
+func (gs *GameService) GetLeaderboard(playerID uint, mode Mode, period leaderboard.Period) (*leaderboard.Leaderboard, error) {
+	return gs.getLeaderboard(nil, playerID, mode, period)
+}
+
+// GetAchievements returns player progress towards every achievement.
+func (gs *GameService) GetAchievements(playerID uint) ([]achievement.Progress, error) {
+
+	facts, err := gs.getAchievementFacts(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gs.achievementService.GetProgress(playerID, facts)
+}
+
+// GetLeague returns league with its members statistics, player must be a member.
+func (gs *GameService) GetLeague(playerID uint, leagueID uint) (*league.LeagueView, error) {
+
+	view, err := gs.leagueService.GetView(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range view.Members {
+
+		member := &view.Members[i]
+		member.Stats, err = gs.GetStats(member.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return view, nil
+}
+
+// GetLeagueLeaderboard returns league members ranking of period in mode, player must be a member.
+func (gs *GameService) GetLeagueLeaderboard(playerID uint, leagueID uint, mode Mode, period leaderboard.Period) (*leaderboard.Leaderboard, error) {
+
+	members, err := gs.leagueService.GetMemberIDs(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gs.getLeaderboard(members, playerID, mode, period)
+}
+
+// GetLeagueStandings returns league members standings on this week puzzles of mode,
+// player must be a member.
+func (gs *GameService) GetLeagueStandings(playerID uint, leagueID uint, mode Mode) (*leaderboard.Standings, error) {
+
+	if !mode.HasDailyPuzzle() {
+		return nil, ErrInvalidMode
+	}
+
+	members, err := gs.leagueService.GetMemberIDs(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := WeekNumbers(time.Today())
+
+	return gs.boardService.GetStandings(string(mode), first, last, members, playerID)
+}
+
+// getLeaderboard returns ranking of period in mode, of given players or public if nil.
+func (gs *GameService) getLeaderboard(playerIDs []uint, playerID uint, mode Mode, period leaderboard.Period) (*leaderboard.Leaderboard, error) {
+
+	if !mode.HasDailyPuzzle() || !period.IsValid() {
+		return nil, ErrInvalidMode
+	}
+
+	if period == leaderboard.AllTime {
+		return gs.boardService.GetAllTime(string(mode), playerIDs, playerID)
+	}
+
+	return gs.boardService.GetDaily(string(mode), PuzzleNumber(time.Today()), playerIDs, playerID)
+}
+
+// /----- POST LOGIC FUNCTIONS -----/
+
+// ProcessGuess compares guessed character from its name with today character of mode,
+// and saves it in player progress.
+func (gs *GameService) ProcessGuess(playerID uint, mode Mode, name string) (*GuessResult, error) {
+
+	if name == "" {
+		return nil, ErrInvalidGuess
+	}
+
+	game, err := gs.getCurrentGame(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(mode, game.Date))
+	if err != nil {
+		return nil, err
+	}
+	if progress.Finished {
+		return nil, ErrPuzzleFinished
+	}
+
+	guess, err := gs.characterService.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := CompareCharacters(guess, &game.CurrentCharacter)
+
+	progress.AddGuess(guessEntry(guess))
+	progress.Finished = result.Correct
+
+	if err := gs.playerService.SaveProgress(progress); err != nil {
+		return nil, err
+	}
+
+	if progress.Finished {
+		guesses, err := gs.replayGuesses(progress.Guesses, &game.CurrentCharacter)
+		if err != nil {
+			return nil, err
+		}
+
+		share := ShareGuesses(mode, PuzzleNumber(game.Date), guesses)
+		if err := gs.recordResult(progress, share, game.CurrentCharacter.ID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// VerifyConnections checks if submitted characters IDs form a group of today connections puzzle,
+// and saves it in player progress.
+func (gs *GameService) VerifyConnections(playerID uint, ids []uint) (*ConnectionsVerdict, error) {
+
+	puzzle, err := gs.getCurrentConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(ConnectionsMode, puzzle.Date))
+	if err != nil {
+		return nil, err
+	}
+	connectionsProgress := puzzle.Replay(progress.Guesses)
+
+	verdict, err := puzzle.Verify(connectionsProgress, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	progress.AddGuess(EncodeSubmission(ids))
+	progress.Finished = connectionsProgress.IsFinished()
+
+	if err := gs.playerService.SaveProgress(progress); err != nil {
+		return nil, err
+	}
+
+	if progress.Finished {
+		share := puzzle.Share(PuzzleNumber(puzzle.Date), progress.Guesses)
+		if err := gs.recordResult(progress, share, 0, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return verdict, nil
+}
+
+// ProcessSpelling compares guessed character name letters with today spelling character,
+// and saves it in player progress. Only names of known characters are allowed.
+func (gs *GameService) ProcessSpelling(playerID uint, name string) (*SpellingResult, error) {
+
+	if name == "" {
+		return nil, ErrInvalidGuess
+	}
+
+	game, err := gs.getCurrentGame(SpellingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, PuzzleKey(SpellingMode, game.Date))
+	if err != nil {
+		return nil, err
+	}
+	spellingProgress := NewSpellingProgress(game, progress.Guesses)
+
+	if spellingProgress.IsFinished() {
+		return nil, ErrPuzzleFinished
+	}
+
+	guess, err := gs.characterService.GetByNormalizedName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := spellingProgress.Spell(guess.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only letters are replayed, same names spell the same
+	progress.AddGuess(guess.Name)
+	progress.Finished = spellingProgress.IsFinished()
+
+	if err := gs.playerService.SaveProgress(progress); err != nil {
+		return nil, err
+	}
+
+	if progress.Finished {
+		share := ShareSpelling(PuzzleNumber(game.Date), spellingProgress)
+		if err := gs.recordResult(progress, share, game.CurrentCharacter.ID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// AnswerHigherLower checks player choice on his current higher or lower round.
+// Continues the run with a new round if correct, saves best streak otherwise.
+func (gs *GameService) AnswerHigherLower(playerID uint, choice string) (*HigherLowerResult, error) {
+
+	run, state := gs.getHigherLowerRun(playerID)
+	if run == nil {
+		return nil, ErrPuzzleFinished
+	}
+
+	round := state.Round
+	correct, err := round.Answer(choice)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HigherLowerResult{
+		Correct: correct,
+		A:       newHigherLowerCard(&round.A, round.Facet, true),
+		B:       newHigherLowerCard(&round.B, round.Facet, true),
+	}
+
+	// Next round is made out of lock and before run is changed, that is left as is on failure.
+	// Revealed character stays for next round.
+	var next *HigherLowerRound
+	if correct {
+		if next, err = gs.newHigherLowerRound(&round.B); err != nil {
+			return nil, err
+		}
+	}
+
+	gs.higherLowerMutex.Lock()
+
+	// Round answered meanwhile by another request
+	if run.Over || run.Round != round {
+		gs.higherLowerMutex.Unlock()
+		return nil, ErrPuzzleFinished
+	}
+
+	if correct {
+		run.Streak++
+		run.Round = next
+		run.PlayedAt = time.Now()
+	} else {
+		run.Over = true
+	}
+
+	result.Streak = run.Streak
+	result.Over = run.Over
+
+	gs.higherLowerMutex.Unlock()
+
+	best, err := gs.scoreService.SubmitScore(playerID, string(HigherLowerMode), result.Streak)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Best = best
+
+	if !result.Over {
+		result.Next = next.View(result.Streak, best)
+	}
+
+	return result, nil
+}
+
+// CreateChallenge creates a challenge on a chosen character and returns its link.
+func (gs *GameService) CreateChallenge(playerID uint, characterID int) (*ChallengeLink, error) {
+
+	char, err := gs.characterService.GetByID(characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, token, err := gs.challengeService.Create(char.ID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChallengeLink(token), nil
+}
+
+// ProcessChallengeGuess compares guessed character from its name with challenge character,
+// and saves it in player progress.
+func (gs *GameService) ProcessChallengeGuess(playerID uint, token string, name string) (*GuessResult, error) {
+
+	if name == "" {
+		return nil, ErrInvalidGuess
+	}
+
+	c, err := gs.challengeService.GetByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := gs.playerService.GetProgress(playerID, ChallengeKey(c.ID))
+	if err != nil {
+		return nil, err
+	}
+	if progress.Finished {
+		return nil, ErrPuzzleFinished
+	}
+
+	answer, err := gs.characterService.GetByID(int(c.CharacterID))
+	if err != nil {
+		return nil, err
+	}
+
+	guess, err := gs.characterService.Resolve(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := CompareCharacters(guess, answer)
+
+	progress.AddGuess(guessEntry(guess))
+	progress.Finished = result.Correct
+
+	if err := gs.playerService.SaveProgress(progress); err != nil {
+		return nil, err
+	}
+
+	if progress.Finished {
+		guesses, err := gs.replayGuesses(progress.Guesses, answer)
+		if err != nil {
+			return nil, err
+		}
+
+		// Challenges are not daily puzzles, never counted in statistics
+		share := ShareGuesses(ClassicMode, PuzzleNumber(c.CreatedAt), guesses)
+		if err := gs.recordResult(progress, share, answer.ID, true); err != nil {
+			return nil, err
+		}
+	}
+
+	// Creator knows the answer, his solves are not counted
+	if result.Correct && !c.IsCreator(playerID) {
+		if err := gs.challengeService.MarkSolved(c, playerID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// recordResult records a finished puzzle in player statistics, with its spoiler-free grid.
+// Puzzles of another day than today are archive plays, not counted.
+// Achievements are then checked on the updated player history.
+func (gs *GameService) recordResult(progress *player.Progress, share *Share, characterID uint, practice bool) error {
+
+	err := gs.statsService.Record(&stats.Result{
+		PlayerID:    progress.PlayerID,
+		Puzzle:      progress.Puzzle,
+		Mode:        string(share.Mode),
+		Number:      share.Number,
+		Won:         share.Solved,
+		Guesses:     len(progress.Guesses),
+		Grid:        share.Grid,
+		Practice:    practice || share.Number != PuzzleNumber(time.Today()),
+		CharacterID: characterID,
+		StartedAt:   progress.CreatedAt,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// An achievements failure does not lose the finished puzzle
+	if err := gs.checkAchievements(progress.PlayerID); err != nil {
+		fmt.Println(time.Today(), "Achievements error:", err)
+	}
+
+	return nil
+}
+
+// checkAchievements unlocks achievements reached by player.
+func (gs *GameService) checkAchievements(playerID uint) error {
+
+	facts, err := gs.getAchievementFacts(playerID)
+	if err != nil {
+		return err
+	}
+
+	_, err = gs.achievementService.Check(playerID, facts)
+	return err
+}
+
+// getAchievementFacts returns player history achievements are evaluated on.
+func (gs *GameService) getAchievementFacts(playerID uint) (*achievement.Facts, error) {
+
+	results, err := gs.statsService.GetResults(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	statViews, err := gs.GetStats(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Played characters too, past daily answers are all played, incomplete ones are left out by facts
+	characters, err := gs.characterService.GetAll()
+	if err != nil {
+		return nil, err
+	}
 
+	return achievement.NewFacts(results, statViews, characters), nil
 }
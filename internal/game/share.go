@@ -0,0 +1,155 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Shared results title
+const shareTitle = "Falloutdle"
+
+// Cell represents a spoiler-free result grid cell, stored as a single letter
+type Cell byte
+
+const (
+	GreenCell  Cell = 'G' // Correct attribute or letter
+	YellowCell Cell = 'Y' // Partial attribute or misplaced letter
+	RedCell    Cell = 'R' // Incorrect attribute or letter
+	BlueCell   Cell = 'B' // Connections group
+	PurpleCell Cell = 'P' // Connections group
+	UpCell     Cell = 'U' // Answer is later
+	DownCell   Cell = 'D' // Answer is earlier
+)
+
+var (
+	cellEmojis = map[Cell]string{
+		GreenCell: "🟩", YellowCell: "🟨", RedCell: "🟥", BlueCell: "🟦", PurpleCell: "🟪",
+		UpCell: "⬆️", DownCell: "⬇️",
+	}
+
+	verdictCells  = map[Verdict]Cell{Correct: GreenCell, Partial: YellowCell, Incorrect: RedCell}
+	hintCells     = map[Hint]Cell{Earlier: DownCell, Later: UpCell}
+	feedbackCells = map[LetterFeedback]Cell{Green: GreenCell, Yellow: YellowCell, Grey: RedCell}
+
+	// Connections groups colors, by group index
+	groupCells = []Cell{YellowCell, GreenCell, BlueCell, PurpleCell}
+)
+
+// Share represents a finished puzzle result as spoiler-free text, without any character name
+type Share struct {
+	Mode     Mode     `json:"mode"`
+	Number   int      `json:"number"`
+	Solved   bool     `json:"solved"`
+	Attempts int      `json:"attempts"`
+	Score    string   `json:"score"` // e.g. "4 guesses", "X/6"
+	Grid     []string `json:"grid"`  // Rows of cells letters
+	Text     string   `json:"text"`
+	CardURL  string   `json:"card_url,omitempty"`
+}
+
+// ShareGuesses returns classic or actor puzzle result, one row per guess
+// and one cell per compared attribute. Dated attributes show an arrow instead of a wrong cell.
+func ShareGuesses(mode Mode, number int, guesses []*GuessResult) *Share {
+
+	grid := make([]string, len(guesses))
+	solved := false
+
+	for i, guess := range guesses {
+
+		row := make([]byte, len(guess.Attributes))
+		for j, attribute := range guess.Attributes {
+			if cell, exists := hintCells[attribute.Hint]; exists && attribute.Verdict != Correct {
+				row[j] = byte(cell)
+			} else {
+				row[j] = byte(verdictCells[attribute.Verdict])
+			}
+		}
+
+		grid[i] = string(row)
+		solved = solved || guess.Correct
+	}
+
+	return NewShare(mode, number, solved, len(guesses), pluralize(len(guesses), "guess", "guesses"), grid)
+}
+
+// ShareSpelling returns spelling puzzle result, one row per guess and one cell per letter.
+func ShareSpelling(number int, progress *SpellingProgress) *Share {
+
+	grid := make([]string, len(progress.Guesses))
+	for i, guess := range progress.Guesses {
+
+		row := make([]byte, len(guess.Letters))
+		for j, letter := range guess.Letters {
+			row[j] = byte(feedbackCells[letter.Feedback])
+		}
+
+		grid[i] = string(row)
+	}
+
+	score := fmt.Sprintf("%d/%d", len(progress.Guesses), SpellingMaxAttempts)
+	if !progress.IsSolved() {
+		score = fmt.Sprintf("X/%d", SpellingMaxAttempts)
+	}
+
+	return NewShare(SpellingMode, number, progress.IsSolved(), len(progress.Guesses), score, grid)
+}
+
+// Share returns connections puzzle result from player submissions,
+// one row per submission and one cell colored by group per character.
+func (p *ConnectionsPuzzle) Share(number int, submissions []string) *Share {
+
+	grid := make([]string, len(submissions))
+	for i, submission := range submissions {
+
+		row := make([]byte, 0, ConnectionsGroupSize)
+		for _, id := range DecodeSubmission(submission) {
+			for index := range p.Groups {
+				if p.Groups[index].contains(id) {
+					row = append(row, byte(groupCells[index%len(groupCells)]))
+				}
+			}
+		}
+
+		grid[i] = string(row)
+	}
+
+	progress := p.Replay(submissions)
+	score := fmt.Sprintf("%d/%d mistakes", progress.Mistakes, ConnectionsMaxMistakes)
+
+	return NewShare(ConnectionsMode, number, progress.IsSolved(), len(submissions), score, grid)
+}
+
+// NewShare creates a share with its text: title line, then grid rows as emojis.
+func NewShare(mode Mode, number int, solved bool, attempts int, score string, grid []string) *Share {
+
+	rows := make([]string, len(grid))
+	for i, row := range grid {
+
+		var emojis strings.Builder
+		for _, cell := range []byte(row) {
+			emojis.WriteString(cellEmojis[Cell(cell)])
+		}
+
+		rows[i] = emojis.String()
+	}
+
+	title := fmt.Sprintf("%s #%d %s %s", shareTitle, number, mode.Title(), score)
+
+	return &Share{
+		Mode:     mode,
+		Number:   number,
+		Solved:   solved,
+		Attempts: attempts,
+		Score:    score,
+		Grid:     grid,
+		Text:     title + "\n\n" + strings.Join(rows, "\n"),
+	}
+}
+
+// pluralize returns count with singular or plural word, e.g. "1 guess", "4 guesses".
+func pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, singular)
+	}
+	return fmt.Sprintf("%d %s", count, plural)
+}
@@ -0,0 +1,153 @@
+package game
+
+import (
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/pkg/strings"
+)
+
+const (
+	SpellingMaxAttempts = 6 // Guesses allowed before game over
+	spellingMinLength   = 3 // Shortest name length playable
+)
+
+// LetterFeedback represents how close a guessed letter is from the answer
+type LetterFeedback string
+
+const (
+	Green  LetterFeedback = "green"  // Right letter at the right place
+	Yellow LetterFeedback = "yellow" // Right letter at the wrong place
+	Grey   LetterFeedback = "grey"   // Letter not in the answer
+)
+
+// LetterResult represents a guessed letter feedback
+type LetterResult struct {
+	Letter   string         `json:"letter"`
+	Feedback LetterFeedback `json:"feedback"`
+}
+
+// SpellingResult represents a guessed name compared letter by letter with the answer
+type SpellingResult struct {
+	Name    string         `json:"name"`
+	Letters []LetterResult `json:"letters"`
+	Correct bool           `json:"correct"`
+}
+
+// SpellingProgress represents a player progress on a spelling puzzle
+type SpellingProgress struct {
+	game    *Game
+	Guesses []SpellingResult
+}
+
+// SpellingView represents a spelling puzzle as shown to a player
+type SpellingView struct {
+	Length      int              `json:"length"`
+	MaxAttempts int              `json:"max_attempts"`
+	Guesses     []SpellingResult `json:"guesses"`
+	Solved      bool             `json:"solved"`
+	Finished    bool             `json:"finished"`
+	Answer      string           `json:"answer,omitempty"` // Revealed once finished
+}
+
+// SpellingName returns the spelled part of a character name, only letters and digits.
+func SpellingName(c *character.Character) string {
+	return strings.NormalizeLetters(c.Name)
+}
+
+// SpellName compares guessed name with answer name letter by letter, Wordle-style.
+// Names may have different lengths, extra letters are compared to the remaining answer letters.
+func SpellName(guess, answer string) []LetterResult {
+
+	guessLetters := []rune(strings.NormalizeLetters(guess))
+	answerLetters := []rune(strings.NormalizeLetters(answer))
+
+	results := make([]LetterResult, len(guessLetters))
+	remaining := make(map[rune]int)
+
+	// Right letters at the right place first
+	for i, letter := range guessLetters {
+
+		results[i] = LetterResult{Letter: string(letter), Feedback: Grey}
+
+		if i < len(answerLetters) && answerLetters[i] == letter {
+			results[i].Feedback = Green
+		}
+	}
+
+	for i, letter := range answerLetters {
+		if i >= len(guessLetters) || guessLetters[i] != letter {
+			remaining[letter]++
+		}
+	}
+
+	// Then right letters at the wrong place, as many as remaining in the answer
+	for i, letter := range guessLetters {
+		if results[i].Feedback != Green && remaining[letter] > 0 {
+			results[i].Feedback = Yellow
+			remaining[letter]--
+		}
+	}
+
+	return results
+}
+
+// IsSolved determines if answer has been guessed.
+func (sp *SpellingProgress) IsSolved() bool {
+	return len(sp.Guesses) > 0 && sp.Guesses[len(sp.Guesses)-1].Correct
+}
+
+// IsFinished determines if puzzle is solved or lost.
+func (sp *SpellingProgress) IsFinished() bool {
+	return sp.IsSolved() || len(sp.Guesses) >= SpellingMaxAttempts
+}
+
+// View returns puzzle state as shown to the player of progress.
+func (sp *SpellingProgress) View() *SpellingView {
+
+	view := &SpellingView{
+		Length:      len([]rune(SpellingName(&sp.game.CurrentCharacter))),
+		MaxAttempts: SpellingMaxAttempts,
+		Guesses:     sp.Guesses,
+		Solved:      sp.IsSolved(),
+		Finished:    sp.IsFinished(),
+	}
+
+	if view.Guesses == nil {
+		view.Guesses = make([]SpellingResult, 0)
+	}
+
+	if view.Finished {
+		view.Answer = sp.game.CurrentCharacter.Name
+	}
+
+	return view
+}
+
+// NewSpellingProgress rebuilds player progress on game from his saved guessed names.
+func NewSpellingProgress(game *Game, names []string) *SpellingProgress {
+
+	progress := &SpellingProgress{game: game}
+	for _, name := range names {
+		progress.Spell(name)
+	}
+
+	return progress
+}
+
+// Spell compares guessed character name with answer and updates progress.
+func (sp *SpellingProgress) Spell(name string) (*SpellingResult, error) {
+
+	if sp.IsFinished() {
+		return nil, ErrPuzzleFinished
+	}
+
+	answer := sp.game.CurrentCharacter.Name
+	result := SpellingResult{
+		Name:    name,
+		Letters: SpellName(name, answer),
+		Correct: strings.NormalizeLetters(name) == strings.NormalizeLetters(answer),
+	}
+
+	sp.Guesses = append(sp.Guesses, result)
+
+	return &result, nil
+}
@@ -0,0 +1,73 @@
+package leaderboard
+
+// Period represents the puzzles a leaderboard ranks
+type Period string
+
+const (
+	Daily   Period = "daily"   // A single daily puzzle
+	AllTime Period = "alltime" // Every daily puzzle
+)
+
+// Leaderboard represents players ranked in a game mode
+type Leaderboard struct {
+	Mode    string  `json:"mode"`
+	Period  Period  `json:"period"`
+	Number  int     `json:"number,omitempty"` // Daily puzzle number
+	Entries []Entry `json:"entries"`
+}
+
+// Entry represents a ranked player, by fewest guesses then fastest solve.
+// All-time values are averages on won puzzles.
+type Entry struct {
+	Rank         int     `json:"rank"`
+	PlayerID     uint    `json:"-"`
+	Name         string  `json:"name"`
+	Guesses      float64 `json:"guesses"`
+	SolveSeconds float64 `json:"solve_seconds"`
+	Wins         int     `json:"wins"`
+	Me           bool    `json:"me,omitempty"` // Entry of requesting player
+}
+
+// Standings represents players ranked on a week of daily puzzles in a game mode
+type Standings struct {
+	Mode        string     `json:"mode"`
+	FirstNumber int        `json:"first_number"`
+	LastNumber  int        `json:"last_number"`
+	Entries     []Standing `json:"entries"`
+}
+
+// Standing represents a ranked player on a week, by most wins then fewest guesses
+type Standing struct {
+	Rank     int    `json:"rank"`
+	PlayerID uint   `json:"-"`
+	Name     string `json:"name"`
+	Played   int    `json:"played"`
+	Wins     int    `json:"wins"`
+	Guesses  int    `json:"guesses"` // Total on won puzzles
+	Me       bool   `json:"me,omitempty"`
+}
+
+// entryRow represents a ranked player as queried, before naming
+type entryRow struct {
+	PlayerID     uint
+	DisplayName  string
+	Username     *string
+	Guesses      float64
+	SolveSeconds float64
+	Wins         int
+}
+
+// standingRow represents a player week results as queried, before naming
+type standingRow struct {
+	PlayerID    uint
+	DisplayName string
+	Username    *string
+	Played      int
+	Wins        int
+	Guesses     int
+}
+
+// IsValid determines if period is known
+func (p Period) IsValid() bool {
+	return p == Daily || p == AllTime
+}
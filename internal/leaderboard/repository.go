@@ -0,0 +1,92 @@
+package leaderboard
+
+import "gorm.io/gorm"
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewLeaderboardRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Solve time of a result, in seconds
+const solveSeconds = "EXTRACT(EPOCH FROM results.finished_at - results.started_at)"
+
+// Player name columns
+const playerColumns = "results.player_id, players.display_name, players.username"
+
+// /----- READ -----/
+
+// GetDaily retrieves best players on a daily puzzle of a game mode
+func (r *Repository) GetDaily(mode string, number int, playerIDs []uint, limit int) ([]entryRow, error) {
+
+	var rows []entryRow
+	result := r.ranked(playerIDs).
+		Select(playerColumns+", results.guesses, "+solveSeconds+" AS solve_seconds, 1 AS wins").
+		Where("results.mode = ? AND results.number = ? AND results.won", mode, number).
+		Order("results.guesses, solve_seconds, results.finished_at").
+		Limit(limit).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return rows, nil
+}
+
+// GetAllTime retrieves best players on all daily puzzles of a game mode, with at least minWins
+func (r *Repository) GetAllTime(mode string, minWins int, playerIDs []uint, limit int) ([]entryRow, error) {
+
+	var rows []entryRow
+	result := r.ranked(playerIDs).
+		Select(playerColumns+", AVG(results.guesses) AS guesses, AVG("+solveSeconds+") AS solve_seconds, COUNT(*) AS wins").
+		Where("results.mode = ? AND results.won", mode).
+		Group(playerColumns).
+		Having("COUNT(*) >= ?", minWins).
+		Order("guesses, solve_seconds, wins DESC").
+		Limit(limit).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return rows, nil
+}
+
+// GetStandings retrieves players results on daily puzzles of a game mode from first to last number
+func (r *Repository) GetStandings(mode string, first int, last int, playerIDs []uint) ([]standingRow, error) {
+
+	var rows []standingRow
+	result := r.ranked(playerIDs).
+		Select(playerColumns+", COUNT(*) AS played, "+
+			"COUNT(*) FILTER (WHERE results.won) AS wins, "+
+			"COALESCE(SUM(results.guesses) FILTER (WHERE results.won), 0) AS guesses").
+		Where("results.mode = ? AND results.number BETWEEN ? AND ?", mode, first, last).
+		Group(playerColumns).
+		Order("wins DESC, guesses, played DESC").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return rows, nil
+}
+
+// ranked returns query on results counted in rankings: daily puzzles of given players,
+// or of all players not opted out of public leaderboards.
+func (r *Repository) ranked(playerIDs []uint) *gorm.DB {
+
+	query := r.db.Table("results").
+		Joins("JOIN players ON players.id = results.player_id").
+		Where("NOT results.practice")
+
+	if playerIDs != nil {
+		return query.Where("results.player_id IN ?", playerIDs)
+	}
+
+	return query.Where("NOT players.hidden")
+}
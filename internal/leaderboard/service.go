@@ -0,0 +1,115 @@
+package leaderboard
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/doruo/falloutdle/internal/player"
+)
+
+const (
+	entriesLimit   = 100 // Players shown on a leaderboard
+	allTimeMinWins = 3   // Wins needed to be ranked all-time, not on a lucky guess
+)
+
+// Service handles players rankings on daily puzzles.
+// Rankings are public, or restricted to a group of players when given.
+type Service struct {
+	repo *Repository
+}
+
+// NewLeaderboardService creates a new leaderboard service
+func NewLeaderboardService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// GetDaily returns leaderboard of a daily puzzle of mode, marking playerID entry
+func (s *Service) GetDaily(mode string, number int, playerIDs []uint, playerID uint) (*Leaderboard, error) {
+
+	rows, err := s.repo.GetDaily(mode, number, playerIDs, entriesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily leaderboard: %w", err)
+	}
+
+	return &Leaderboard{
+		Mode:    mode,
+		Period:  Daily,
+		Number:  number,
+		Entries: rank(rows, playerID),
+	}, nil
+}
+
+// GetAllTime returns leaderboard of all daily puzzles of mode, marking playerID entry
+func (s *Service) GetAllTime(mode string, playerIDs []uint, playerID uint) (*Leaderboard, error) {
+
+	rows, err := s.repo.GetAllTime(mode, allTimeMinWins, playerIDs, entriesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all-time leaderboard: %w", err)
+	}
+
+	return &Leaderboard{
+		Mode:    mode,
+		Period:  AllTime,
+		Entries: rank(rows, playerID),
+	}, nil
+}
+
+// GetStandings returns players standings on daily puzzles of mode from first to last number,
+// marking playerID entry
+func (s *Service) GetStandings(mode string, first int, last int, playerIDs []uint, playerID uint) (*Standings, error) {
+
+	rows, err := s.repo.GetStandings(mode, first, last, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get standings: %w", err)
+	}
+
+	standings := &Standings{
+		Mode:        mode,
+		FirstNumber: first,
+		LastNumber:  last,
+		Entries:     make([]Standing, len(rows)),
+	}
+
+	for i, row := range rows {
+
+		standings.Entries[i] = Standing{
+			Rank:     i + 1,
+			PlayerID: row.PlayerID,
+			Name:     player.PublicName(row.DisplayName, row.Username),
+			Played:   row.Played,
+			Wins:     row.Wins,
+			Guesses:  row.Guesses,
+			Me:       playerID != 0 && row.PlayerID == playerID,
+		}
+
+		if i > 0 && rows[i-1].Wins == row.Wins && rows[i-1].Guesses == row.Guesses {
+			standings.Entries[i].Rank = standings.Entries[i-1].Rank
+		}
+	}
+
+	return standings, nil
+}
+
+// rank creates entries from ordered rows. Tied rows share a rank, the next one skips it (1, 1, 3).
+func rank(rows []entryRow, playerID uint) []Entry {
+
+	entries := make([]Entry, len(rows))
+	for i, row := range rows {
+
+		entries[i] = Entry{
+			Rank:         i + 1,
+			PlayerID:     row.PlayerID,
+			Name:         player.PublicName(row.DisplayName, row.Username),
+			Guesses:      row.Guesses,
+			SolveSeconds: math.Round(row.SolveSeconds),
+			Wins:         row.Wins,
+			Me:           playerID != 0 && row.PlayerID == playerID,
+		}
+
+		if i > 0 && entries[i-1].Guesses == entries[i].Guesses && entries[i-1].SolveSeconds == entries[i].SolveSeconds {
+			entries[i].Rank = entries[i-1].Rank
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,87 @@
+package league
+
+import (
+	"time"
+
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+// Role represents a member permissions in a league
+type Role string
+
+const (
+	AdminRole  Role = "admin"  // Renames league and removes members
+	MemberRole Role = "member" // Plays in league rankings
+)
+
+// League represents a private group of players, joined with an invite code
+type League struct {
+	ID         uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name       string    `json:"name" gorm:"size:40"`
+	InviteCode string    `json:"invite_code" gorm:"size:16;uniqueIndex"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Member represents a player membership in a league
+type Member struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	LeagueID  uint      `json:"-" gorm:"uniqueIndex:idx_member_league_player"`
+	PlayerID  uint      `json:"-" gorm:"uniqueIndex:idx_member_league_player;index"`
+	Role      Role      `json:"role" gorm:"size:20"`
+	CreatedAt time.Time `json:"joined_at"`
+}
+
+// LeagueView represents a league as shown to its members
+type LeagueView struct {
+	League
+	Members []MemberView `json:"members"`
+}
+
+// MemberView represents a league member with his public name and statistics
+type MemberView struct {
+	Member
+	Name  string           `json:"name"`
+	Me    bool             `json:"me,omitempty"`
+	Stats []stats.StatView `json:"stats"`
+}
+
+// Membership represents a player membership with its league
+type Membership struct {
+	League   League    `json:"league"`
+	Role     Role      `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// memberRow represents a league member as queried, with his player name columns
+type memberRow struct {
+	Member
+	DisplayName string
+	Username    *string
+}
+
+// NewLeague creates a new League instance
+func NewLeague(name string, inviteCode string) *League {
+	return &League{
+		Name:       name,
+		InviteCode: inviteCode,
+	}
+}
+
+// NewMember creates a new Member instance of player in league
+func NewMember(leagueID uint, playerID uint, role Role) *Member {
+	return &Member{
+		LeagueID: leagueID,
+		PlayerID: playerID,
+		Role:     role,
+	}
+}
+
+// TableName overrides default table name to avoid conflicts with other members
+func (Member) TableName() string {
+	return "league_members"
+}
+
+// IsAdmin determines if member can manage the league
+func (m *Member) IsAdmin() bool {
+	return m.Role == AdminRole
+}
@@ -0,0 +1,271 @@
+package league
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewLeagueRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// /----- CREATE -----/
+
+// Add creates a new league record in the database, with its first member
+func (r *Repository) Add(league *League, member *Member) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := tx.Create(league).Error; err != nil {
+			return err
+		}
+
+		member.LeagueID = league.ID
+		return tx.Create(member).Error
+	})
+}
+
+// AddMember creates a new member record in the database,
+// returns false if player is already a member
+func (r *Repository) AddMember(member *Member) (bool, error) {
+
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(member)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// /----- READ -----/
+
+// GetByID retrieves a league by its ID
+func (r *Repository) GetByID(id uint) (*League, error) {
+
+	var league League
+	result := r.db.First(&league, id)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("league not found")
+		}
+		return nil, result.Error
+	}
+
+	return &league, nil
+}
+
+// GetByInviteCode retrieves a league by its invite code
+func (r *Repository) GetByInviteCode(code string) (*League, error) {
+
+	var league League
+	result := r.db.Where("invite_code = ?", code).First(&league)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("league not found")
+		}
+		return nil, result.Error
+	}
+
+	return &league, nil
+}
+
+// GetByPlayer retrieves leagues a player is member of
+func (r *Repository) GetByPlayer(playerID uint) ([]League, error) {
+
+	var leagues []League
+	result := r.db.
+		Joins("JOIN league_members ON league_members.league_id = leagues.id").
+		Where("league_members.player_id = ?", playerID).
+		Order("leagues.name").
+		Find(&leagues)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return leagues, nil
+}
+
+// GetMember retrieves a player membership in a league
+func (r *Repository) GetMember(leagueID uint, playerID uint) (*Member, error) {
+
+	var member Member
+	result := r.db.Where("league_id = ? AND player_id = ?", leagueID, playerID).First(&member)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("member not found")
+		}
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetMemberByID retrieves a league member by its ID
+func (r *Repository) GetMemberByID(leagueID uint, id uint) (*Member, error) {
+
+	var member Member
+	result := r.db.Where("league_id = ? AND id = ?", leagueID, id).First(&member)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("member not found")
+		}
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetMembers retrieves a league members in joining order, with their player name columns
+func (r *Repository) GetMembers(leagueID uint) ([]memberRow, error) {
+
+	var rows []memberRow
+	result := r.db.Table("league_members").
+		Select("league_members.*, players.display_name, players.username").
+		Joins("JOIN players ON players.id = league_members.player_id").
+		Where("league_members.league_id = ?", leagueID).
+		Order("league_members.created_at, league_members.id").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return rows, nil
+}
+
+// CountMembers returns a league members count
+func (r *Repository) CountMembers(leagueID uint) (int64, error) {
+
+	var count int64
+	result := r.db.Model(&Member{}).Where("league_id = ?", leagueID).Count(&count)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+// /----- UPDATE -----/
+
+// Rename updates a league name
+func (r *Repository) Rename(league *League, name string) error {
+
+	if err := r.db.Model(league).Update("name", name).Error; err != nil {
+		return err
+	}
+
+	league.Name = name
+	return nil
+}
+
+// SetRole updates a member role
+func (r *Repository) SetRole(member *Member, role Role) error {
+
+	if err := r.db.Model(member).Update("role", role).Error; err != nil {
+		return err
+	}
+
+	member.Role = role
+	return nil
+}
+
+// MergePlayer moves player from memberships to player to, keeping the highest role in a same league
+func (r *Repository) MergePlayer(from uint, to uint) error {
+
+	var members []Member
+	if err := r.db.Where("player_id = ?", from).Find(&members).Error; err != nil {
+		return err
+	}
+
+	for _, member := range members {
+
+		kept, err := r.GetMember(member.LeagueID, to)
+		if err != nil {
+			if err := r.db.Model(&member).Update("player_id", to).Error; err != nil {
+				return err
+			}
+			continue
+		}
+
+		if member.IsAdmin() && !kept.IsAdmin() {
+			if err := r.SetRole(kept, AdminRole); err != nil {
+				return err
+			}
+		}
+
+		if err := r.db.Delete(&member).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// /----- DELETE -----/
+
+// DeleteMember deletes a member by its ID
+func (r *Repository) DeleteMember(id uint) error {
+
+	result := r.db.Delete(&Member{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("member not found")
+	}
+
+	return nil
+}
+
+// Leave deletes a member in a single transaction. When the last admin leaves, the oldest member
+// becomes admin, and the league is deleted when the last member leaves.
+func (r *Repository) Leave(league *League, member *Member) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+
+		repo := NewLeagueRepository(tx)
+		if err := repo.DeleteMember(member.ID); err != nil {
+			return err
+		}
+
+		rows, err := repo.GetMembers(league.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == 0 {
+			return repo.Delete(league)
+		}
+
+		for _, row := range rows {
+			if row.IsAdmin() {
+				return nil
+			}
+		}
+
+		return repo.SetRole(&rows[0].Member, AdminRole)
+	})
+}
+
+// Delete deletes a league with all its members
+func (r *Repository) Delete(league *League) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := tx.Where("league_id = ?", league.ID).Delete(&Member{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(league).Error
+	})
+}
@@ -0,0 +1,290 @@
+package league
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/doruo/falloutdle/internal/player"
+	"github.com/doruo/falloutdle/pkg/profanity"
+	"github.com/doruo/falloutdle/pkg/secret"
+)
+
+const (
+	nameMinLength    = 3
+	nameMaxLength    = 40
+	inviteCodeLength = 8
+	maxMembers       = 50 // Members of a league, keeps rankings readable
+)
+
+var (
+	ErrLeagueNotFound = errors.New("league not found")
+	ErrMemberNotFound = errors.New("member not found")
+	ErrNotMember      = errors.New("player is not a league member")
+	ErrNotAdmin       = errors.New("only league admins can do this")
+	ErrInvalidName    = errors.New("league name must be 3 to 40 characters")
+	ErrProfaneName    = errors.New("league name contains inappropriate words")
+	ErrLeagueFull     = errors.New("league is full")
+	ErrRemoveAdmin    = errors.New("league admins can not be removed")
+)
+
+// Service handles private leagues and their members
+type Service struct {
+	repo *Repository
+}
+
+// NewLeagueService creates a new league service
+func NewLeagueService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// /----- GET FUNCTIONS -----/
+
+// GetByPlayer returns leagues player is member of
+func (s *Service) GetByPlayer(playerID uint) ([]League, error) {
+
+	leagues, err := s.repo.GetByPlayer(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player leagues: %w", err)
+	}
+
+	return leagues, nil
+}
+
+// GetView returns league with its members as shown to player, who must be a member
+func (s *Service) GetView(leagueID uint, playerID uint) (*LeagueView, error) {
+
+	league, _, err := s.getMembership(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.GetMembers(league.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league members: %w", err)
+	}
+
+	view := &LeagueView{League: *league, Members: make([]MemberView, len(rows))}
+	for i, row := range rows {
+		view.Members[i] = MemberView{
+			Member: row.Member,
+			Name:   player.PublicName(row.DisplayName, row.Username),
+			Me:     row.PlayerID == playerID,
+		}
+	}
+
+	return view, nil
+}
+
+// GetMemberIDs returns league members player IDs, player must be a member
+func (s *Service) GetMemberIDs(leagueID uint, playerID uint) ([]uint, error) {
+
+	view, err := s.GetView(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(view.Members))
+	for i, member := range view.Members {
+		ids[i] = member.PlayerID
+	}
+
+	return ids, nil
+}
+
+// GetMemberships returns player memberships with their leagues
+func (s *Service) GetMemberships(playerID uint) ([]Membership, error) {
+
+	leagues, err := s.GetByPlayer(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]Membership, 0, len(leagues))
+	for _, league := range leagues {
+
+		member, err := s.repo.GetMember(league.ID, playerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get membership: %w", err)
+		}
+
+		memberships = append(memberships, Membership{League: league, Role: member.Role, JoinedAt: member.CreatedAt})
+	}
+
+	return memberships, nil
+}
+
+// /----- MEMBERSHIP FUNCTIONS -----/
+
+// Create creates a new league with player as its admin
+func (s *Service) Create(playerID uint, name string) (*League, error) {
+
+	name, err := validateName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	league := NewLeague(name, secret.RandomCode(inviteCodeLength))
+	if err := s.repo.Add(league, NewMember(0, playerID, AdminRole)); err != nil {
+		return nil, fmt.Errorf("failed to create league: %w", err)
+	}
+
+	return league, nil
+}
+
+// Join adds player to league of invite code, nothing if already a member
+func (s *Service) Join(playerID uint, code string) (*League, error) {
+
+	league, err := s.repo.GetByInviteCode(strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, ErrLeagueNotFound
+	}
+
+	if _, err := s.repo.GetMember(league.ID, playerID); err == nil {
+		return league, nil
+	}
+
+	count, err := s.repo.CountMembers(league.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count league members: %w", err)
+	}
+
+	if count >= maxMembers {
+		return nil, ErrLeagueFull
+	}
+
+	if _, err := s.repo.AddMember(NewMember(league.ID, playerID, MemberRole)); err != nil {
+		return nil, fmt.Errorf("failed to join league: %w", err)
+	}
+
+	return league, nil
+}
+
+// Leave removes player from league. When its last admin leaves, the oldest member becomes admin,
+// and the league is deleted when its last member leaves.
+func (s *Service) Leave(leagueID uint, playerID uint) error {
+
+	league, member, err := s.getMembership(leagueID, playerID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Leave(league, member); err != nil {
+		return fmt.Errorf("failed to leave league: %w", err)
+	}
+
+	return nil
+}
+
+// LeaveAll removes player from all his leagues
+func (s *Service) LeaveAll(playerID uint) error {
+
+	leagues, err := s.GetByPlayer(playerID)
+	if err != nil {
+		return err
+	}
+
+	for _, league := range leagues {
+		if err := s.Leave(league.ID, playerID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// /----- ADMIN FUNCTIONS -----/
+
+// Rename updates league name, player must be an admin
+func (s *Service) Rename(leagueID uint, playerID uint, name string) (*League, error) {
+
+	league, err := s.getAsAdmin(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err = validateName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Rename(league, name); err != nil {
+		return nil, fmt.Errorf("failed to rename league: %w", err)
+	}
+
+	return league, nil
+}
+
+// RemoveMember removes a member from league, player must be an admin.
+// Admins can not be removed, they leave by themselves.
+func (s *Service) RemoveMember(leagueID uint, playerID uint, memberID uint) error {
+
+	if _, err := s.getAsAdmin(leagueID, playerID); err != nil {
+		return err
+	}
+
+	member, err := s.repo.GetMemberByID(leagueID, memberID)
+	if err != nil {
+		return ErrMemberNotFound
+	}
+
+	if member.IsAdmin() {
+		return ErrRemoveAdmin
+	}
+
+	if err := s.repo.DeleteMember(member.ID); err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	return nil
+}
+
+// /----- PRIVATE FUNCTIONS -----/
+
+// getMembership retrieves league and player membership, if player is one of its members
+func (s *Service) getMembership(leagueID uint, playerID uint) (*League, *Member, error) {
+
+	league, err := s.repo.GetByID(leagueID)
+	if err != nil {
+		return nil, nil, ErrLeagueNotFound
+	}
+
+	member, err := s.repo.GetMember(leagueID, playerID)
+	if err != nil {
+		return nil, nil, ErrNotMember
+	}
+
+	return league, member, nil
+}
+
+// getAsAdmin retrieves league, if player is one of its admins
+func (s *Service) getAsAdmin(leagueID uint, playerID uint) (*League, error) {
+
+	league, member, err := s.getMembership(leagueID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !member.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+
+	return league, nil
+}
+
+// validateName returns league name trimmed, or an error if invalid
+func validateName(name string) (string, error) {
+
+	name = strings.TrimSpace(name)
+
+	if length := utf8.RuneCountInString(name); length < nameMinLength || length > nameMaxLength {
+		return "", ErrInvalidName
+	}
+
+	if profanity.IsProfane(name) {
+		return "", ErrProfaneName
+	}
+
+	return name, nil
+}
@@ -0,0 +1,48 @@
+package player
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidCookie = errors.New("invalid session cookie")
+
+// CookieSigner signs session tokens stored in cookies, so they can not be forged
+type CookieSigner struct {
+	key []byte
+}
+
+// NewCookieSigner creates a new CookieSigner from key
+func NewCookieSigner(key []byte) *CookieSigner {
+	return &CookieSigner{key: key}
+}
+
+// Sign returns cookie value holding token and its signature.
+func (cs *CookieSigner) Sign(token string) string {
+	return token + "." + cs.signature(token)
+}
+
+// Verify returns token from cookie value, fails if signature does not match.
+func (cs *CookieSigner) Verify(value string) (string, error) {
+
+	token, signature, found := strings.Cut(value, ".")
+	if !found || token == "" {
+		return "", ErrInvalidCookie
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(cs.signature(token))) {
+		return "", ErrInvalidCookie
+	}
+
+	return token, nil
+}
+
+// signature returns token HMAC-SHA256, base64 encoded.
+func (cs *CookieSigner) signature(token string) string {
+	mac := hmac.New(sha256.New, cs.key)
+	mac.Write([]byte(token))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,113 @@
+package player
+
+import "time"
+
+// Name shown for players without display name nor username
+const DefaultName = "Vault Dweller"
+
+// Player represents a player identified by his sessions, anonymous until registered
+type Player struct {
+	ID           uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	Username     *string   `json:"username,omitempty" gorm:"size:32;uniqueIndex"` // Nil while anonymous
+	PasswordHash string    `json:"-" gorm:"size:255"`
+	DisplayName  string    `json:"display_name,omitempty" gorm:"size:32"` // Name shown on leaderboards
+	Hidden       bool      `json:"hidden"`                                // Opted out of leaderboards
+	CreatedAt    time.Time `json:"created_at"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+}
+
+// Session represents a player device session, referenced by signed cookie
+type Session struct {
+	Token      string    `json:"-" gorm:"primaryKey;size:64"`
+	PlayerID   uint      `json:"-" gorm:"index"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// Progress represents a player in-progress guesses on a puzzle
+type Progress struct {
+	ID        uint      `json:"-" gorm:"primaryKey;autoIncrement"`
+	PlayerID  uint      `json:"-" gorm:"uniqueIndex:idx_progress_player_puzzle"`
+	Puzzle    string    `json:"puzzle" gorm:"size:100;uniqueIndex:idx_progress_player_puzzle"` // Mode and day or challenge
+	Guesses   []string  `json:"guesses" gorm:"serializer:json"`
+	Finished  bool      `json:"finished"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TransferCode represents a short single-use code moving a player to another device
+type TransferCode struct {
+	Code      string    `json:"code" gorm:"primaryKey;size:16"`
+	PlayerID  uint      `json:"-" gorm:"index"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"index"`
+	CreatedAt time.Time `json:"-"`
+}
+
+// NewPlayer creates a new Player instance
+func NewPlayer() *Player {
+	return &Player{
+		LastSeenAt: time.Now(),
+	}
+}
+
+// IsRegistered determines if player has an account with credentials
+func (p *Player) IsRegistered() bool {
+	return p.Username != nil
+}
+
+// PublicName returns player name shown to others
+func (p *Player) PublicName() string {
+	return PublicName(p.DisplayName, p.Username)
+}
+
+// PublicName returns a player name shown to others: display name, username or default name
+func PublicName(displayName string, username *string) string {
+
+	if displayName != "" {
+		return displayName
+	}
+
+	if username != nil {
+		return *username
+	}
+
+	return DefaultName
+}
+
+// NewSession creates a new Session instance for player
+func NewSession(token string, playerID uint) *Session {
+	return &Session{
+		Token:      token,
+		PlayerID:   playerID,
+		LastSeenAt: time.Now(),
+	}
+}
+
+// NewTransferCode creates a new TransferCode instance for player, valid for ttl
+func NewTransferCode(code string, playerID uint, ttl time.Duration) *TransferCode {
+	return &TransferCode{
+		Code:      code,
+		PlayerID:  playerID,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+}
+
+// IsExpired determines if code can no longer be redeemed
+func (tc *TransferCode) IsExpired() bool {
+	return time.Now().After(tc.ExpiresAt)
+}
+
+// NewProgress creates a new Progress instance for player on puzzle
+func NewProgress(playerID uint, puzzle string) *Progress {
+	return &Progress{
+		PlayerID: playerID,
+		Puzzle:   puzzle,
+		Guesses:  make([]string, 0),
+	}
+}
+
+// AddGuess appends guess to progress
+func (p *Progress) AddGuess(guess string) *Progress {
+	p.Guesses = append(p.Guesses, guess)
+	return p
+}
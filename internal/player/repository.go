@@ -0,0 +1,309 @@
+package player
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewPlayerRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// /----- CREATE -----/
+
+// Add creates a new player record in the database
+func (r *Repository) Add(player *Player) error {
+
+	result := r.db.Create(player)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// AddSession creates a new session record in the database
+func (r *Repository) AddSession(session *Session) error {
+
+	result := r.db.Create(session)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// AddTransferCode creates a new transfer code record in the database,
+// replacing player previous codes and removing expired ones
+func (r *Repository) AddTransferCode(code *TransferCode) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := tx.Where("player_id = ? OR expires_at < ?", code.PlayerID, time.Now()).Delete(&TransferCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(code).Error
+	})
+}
+
+// /----- READ -----/
+
+// GetByID retrieves a player by its ID
+func (r *Repository) GetByID(id uint) (*Player, error) {
+
+	var player Player
+	result := r.db.First(&player, id)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("player not found")
+		}
+		return nil, result.Error
+	}
+
+	return &player, nil
+}
+
+// GetByUsername retrieves a registered player by its username, case insensitive
+func (r *Repository) GetByUsername(username string) (*Player, error) {
+
+	var player Player
+	result := r.db.Where("LOWER(username) = LOWER(?)", username).First(&player)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("player not found")
+		}
+		return nil, result.Error
+	}
+
+	return &player, nil
+}
+
+// GetSession retrieves a session by its token
+func (r *Repository) GetSession(token string) (*Session, error) {
+
+	var session Session
+	result := r.db.Where("token = ?", token).First(&session)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("session not found")
+		}
+		return nil, result.Error
+	}
+
+	return &session, nil
+}
+
+// GetSessions retrieves a player sessions
+func (r *Repository) GetSessions(playerID uint) ([]Session, error) {
+
+	var sessions []Session
+	result := r.db.Where("player_id = ?", playerID).Order("created_at").Find(&sessions)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return sessions, nil
+}
+
+// GetAllProgress retrieves a player progress on all puzzles
+func (r *Repository) GetAllProgress(playerID uint) ([]Progress, error) {
+
+	var progress []Progress
+	result := r.db.Where("player_id = ?", playerID).Order("created_at").Find(&progress)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return progress, nil
+}
+
+// GetProgress retrieves a player progress on a puzzle
+func (r *Repository) GetProgress(playerID uint, puzzle string) (*Progress, error) {
+
+	var progress Progress
+	result := r.db.Where("player_id = ? AND puzzle = ?", playerID, puzzle).First(&progress)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrProgressNotFound
+		}
+		return nil, result.Error
+	}
+
+	return &progress, nil
+}
+
+// /----- UPDATE -----/
+
+// SaveProgress creates or updates a progress record in the database
+func (r *Repository) SaveProgress(progress *Progress) error {
+
+	if progress == nil {
+		return errors.New("progress cannot be nil")
+	}
+
+	result := r.db.Save(progress)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// SetCredentials sets player username and password hash
+func (r *Repository) SetCredentials(player *Player, username string, passwordHash string) error {
+
+	result := r.db.Model(player).Updates(map[string]any{
+		"username":      username,
+		"password_hash": passwordHash,
+	})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	player.Username = &username
+	player.PasswordHash = passwordHash
+
+	return nil
+}
+
+// SetProfile sets player display name and leaderboards opt-out
+func (r *Repository) SetProfile(player *Player, displayName string, hidden bool) error {
+
+	result := r.db.Model(player).Updates(map[string]any{
+		"display_name": displayName,
+		"hidden":       hidden,
+	})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	player.DisplayName = displayName
+	player.Hidden = hidden
+
+	return nil
+}
+
+// MergePlayer moves player from progress to player to, whose progress is kept on a same puzzle.
+// Sessions and transfer codes of player from are deleted.
+func (r *Repository) MergePlayer(from uint, to uint) error {
+
+	kept := r.db.Model(&Progress{}).Select("puzzle").Where("player_id = ?", to)
+
+	if err := r.db.Where("player_id = ? AND puzzle IN (?)", from, kept).Delete(&Progress{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(&Progress{}).Where("player_id = ?", from).Update("player_id", to).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Where("player_id = ?", from).Delete(&TransferCode{}).Error; err != nil {
+		return err
+	}
+
+	return r.db.Where("player_id = ?", from).Delete(&Session{}).Error
+}
+
+// TouchSession updates session and its player last seen date
+func (r *Repository) TouchSession(session *Session) error {
+
+	now := time.Now()
+	session.LastSeenAt = now
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := tx.Model(session).Update("last_seen_at", now).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&Player{}).Where("id = ?", session.PlayerID).Update("last_seen_at", now).Error
+	})
+}
+
+// /----- DELETE -----/
+
+// TakeTransferCode deletes a transfer code and returns it, so it can only be taken once
+func (r *Repository) TakeTransferCode(code string) (*TransferCode, error) {
+
+	var transferCode TransferCode
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+
+		if err := tx.Where("code = ?", code).First(&transferCode).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("code = ?", code).Delete(&TransferCode{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Taken meanwhile by a concurrent request
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("transfer code not found")
+		}
+		return nil, err
+	}
+
+	return &transferCode, nil
+}
+
+// DeleteByID deletes a player by its ID
+func (r *Repository) DeleteByID(id uint) error {
+
+	result := r.db.Delete(&Player{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("player not found")
+	}
+
+	return nil
+}
+
+// DeleteSession deletes a session by its token
+func (r *Repository) DeleteSession(token string) error {
+
+	result := r.db.Where("token = ?", token).Delete(&Session{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteByPlayer deletes a player progress, sessions and transfer codes, then the player
+func (r *Repository) DeleteByPlayer(playerID uint) error {
+
+	for _, model := range []any{&Progress{}, &Session{}, &TransferCode{}} {
+		if err := r.db.Where("player_id = ?", playerID).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	return r.DeleteByID(playerID)
+}
@@ -0,0 +1,302 @@
+package player
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/doruo/falloutdle/pkg/profanity"
+	"github.com/doruo/falloutdle/pkg/secret"
+)
+
+// Session last seen date is only updated once per period
+const touchPeriod = time.Hour
+
+const (
+	transferCodeLength = 8               // ~8.5e11 possible codes
+	transferCodeTTL    = 5 * time.Minute // Delay to redeem a transfer code
+)
+
+const (
+	usernameMinLength = 3
+	usernameMaxLength = 32
+	passwordMinLength = 8
+	passwordMaxLength = 128
+	displayNameMax    = 32
+)
+
+var (
+	ErrInvalidUsername    = errors.New("username must be 3 to 32 letters, digits, '-' or '_'")
+	ErrInvalidPassword    = errors.New("password must be 8 to 128 characters")
+	ErrUsernameTaken      = errors.New("username already taken")
+	ErrAlreadyRegistered  = errors.New("player already registered")
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrInvalidTransfer    = errors.New("invalid or expired transfer code")
+	ErrInvalidName        = errors.New("display name must be at most 32 characters")
+	ErrProfaneName        = errors.New("name contains inappropriate words")
+	ErrProgressNotFound   = errors.New("progress not found")
+)
+
+// Hash compared when username is unknown, so that login takes as long as with a known one
+var dummyPasswordHash = HashPassword("falloutdle")
+
+// Service handles players, their sessions and progress
+type Service struct {
+	repo   *Repository
+	signer *CookieSigner
+}
+
+// NewPlayerService creates a new player service
+func NewPlayerService(repo *Repository, signer *CookieSigner) *Service {
+	return &Service{repo: repo, signer: signer}
+}
+
+// NewDefaultCookieSigner creates a new CookieSigner with key from SESSION_SECRET environment variable
+func NewDefaultCookieSigner() *CookieSigner {
+	return NewCookieSigner(secret.Key("SESSION_SECRET"))
+}
+
+// /----- SESSION FUNCTIONS -----/
+
+// GetByCookie retrieves the player and session of a signed session cookie value
+func (s *Service) GetByCookie(value string) (*Player, *Session, error) {
+
+	token, err := s.signer.Verify(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session, err := s.repo.GetSession(token)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	player, err := s.repo.GetByID(session.PlayerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get session player: %w", err)
+	}
+
+	if time.Since(session.LastSeenAt) > touchPeriod {
+		s.repo.TouchSession(session)
+	}
+
+	return player, session, nil
+}
+
+// NewAnonymous creates a new anonymous player with a session
+func (s *Service) NewAnonymous() (*Player, *Session, error) {
+
+	player := NewPlayer()
+	if err := s.repo.Add(player); err != nil {
+		return nil, nil, fmt.Errorf("failed to create player: %w", err)
+	}
+
+	session, err := s.NewSession(player.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return player, session, nil
+}
+
+// NewSession creates a new session for player
+func (s *Service) NewSession(playerID uint) (*Session, error) {
+
+	session := NewSession(secret.RandomToken(32), playerID)
+	if err := s.repo.AddSession(session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return session, nil
+}
+
+// RotateSession replaces session with a new one for player, as on login or logout,
+// so that a session token is never reused across identities
+func (s *Service) RotateSession(session *Session, playerID uint) (*Session, error) {
+
+	if err := s.EndSession(session); err != nil {
+		return nil, err
+	}
+
+	return s.NewSession(playerID)
+}
+
+// EndSession deletes session, its cookie is no longer valid
+func (s *Service) EndSession(session *Session) error {
+
+	if session == nil {
+		return nil
+	}
+
+	if err := s.repo.DeleteSession(session.Token); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	return nil
+}
+
+// Cookie returns session signed cookie value
+func (s *Service) Cookie(session *Session) string {
+	return s.signer.Sign(session.Token)
+}
+
+// /----- ACCOUNT FUNCTIONS -----/
+
+// Register sets anonymous player credentials, keeping all his history
+func (s *Service) Register(player *Player, username string, password string) error {
+
+	if player.IsRegistered() {
+		return ErrAlreadyRegistered
+	}
+
+	if !isValidUsername(username) {
+		return ErrInvalidUsername
+	}
+
+	if profanity.IsProfane(username) {
+		return ErrProfaneName
+	}
+
+	if length := len(password); length < passwordMinLength || length > passwordMaxLength {
+		return ErrInvalidPassword
+	}
+
+	if _, err := s.repo.GetByUsername(username); err == nil {
+		return ErrUsernameTaken
+	}
+
+	if err := s.repo.SetCredentials(player, username, HashPassword(password)); err != nil {
+		return fmt.Errorf("failed to register player: %w", err)
+	}
+
+	return nil
+}
+
+// Authenticate retrieves registered player matching credentials
+func (s *Service) Authenticate(username string, password string) (*Player, error) {
+
+	player, err := s.repo.GetByUsername(username)
+	if err != nil {
+		VerifyPassword(password, dummyPasswordHash)
+		return nil, ErrInvalidCredentials
+	}
+
+	if ok, err := VerifyPassword(password, player.PasswordHash); err != nil || !ok {
+		return nil, ErrInvalidCredentials
+	}
+
+	return player, nil
+}
+
+// isValidUsername determines if username has a valid length and only letters, digits, '-' or '_'
+func isValidUsername(username string) bool {
+
+	if length := len(username); length < usernameMinLength || length > usernameMaxLength {
+		return false
+	}
+
+	for _, r := range username {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+
+		if !isLetter && !isDigit && r != '-' && r != '_' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// /----- PROFILE FUNCTIONS -----/
+
+// UpdateProfile sets player display name, empty to remove it, and leaderboards opt-out
+func (s *Service) UpdateProfile(player *Player, displayName string, hidden bool) error {
+
+	displayName = strings.TrimSpace(displayName)
+
+	if utf8.RuneCountInString(displayName) > displayNameMax {
+		return ErrInvalidName
+	}
+
+	if profanity.IsProfane(displayName) {
+		return ErrProfaneName
+	}
+
+	if err := s.repo.SetProfile(player, displayName, hidden); err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	}
+
+	return nil
+}
+
+// /----- TRANSFER FUNCTIONS -----/
+
+// NewTransferCode creates a single-use code for player, replacing his previous one
+func (s *Service) NewTransferCode(playerID uint) (*TransferCode, error) {
+
+	code := NewTransferCode(secret.RandomCode(transferCodeLength), playerID, transferCodeTTL)
+	if err := s.repo.AddTransferCode(code); err != nil {
+		return nil, fmt.Errorf("failed to create transfer code: %w", err)
+	}
+
+	return code, nil
+}
+
+// RedeemTransferCode consumes transfer code and retrieves its player.
+// Code is case insensitive, spaces and dashes are ignored.
+func (s *Service) RedeemTransferCode(code string) (*Player, error) {
+
+	code = normalizeTransferCode(code)
+	if len(code) != transferCodeLength {
+		return nil, ErrInvalidTransfer
+	}
+
+	transferCode, err := s.repo.TakeTransferCode(code)
+	if err != nil || transferCode.IsExpired() {
+		return nil, ErrInvalidTransfer
+	}
+
+	player, err := s.repo.GetByID(transferCode.PlayerID)
+	if err != nil {
+		return nil, ErrInvalidTransfer
+	}
+
+	return player, nil
+}
+
+// normalizeTransferCode returns code in upper case, without spaces and dashes
+func normalizeTransferCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// /----- PROGRESS FUNCTIONS -----/
+
+// GetProgress retrieves player progress on puzzle, empty if none found
+func (s *Service) GetProgress(playerID uint, puzzle string) (*Progress, error) {
+
+	progress, err := s.repo.GetProgress(playerID, puzzle)
+	switch {
+	case errors.Is(err, ErrProgressNotFound):
+		return NewProgress(playerID, puzzle), nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get progress: %w", err)
+	}
+
+	return progress, nil
+}
+
+// SaveProgress saves player progress on puzzle
+func (s *Service) SaveProgress(progress *Progress) error {
+
+	if progress.PlayerID == 0 {
+		return errors.New("invalid player ID")
+	}
+
+	if err := s.repo.SaveProgress(progress); err != nil {
+		return fmt.Errorf("failed to save progress: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package score
+
+import "time"
+
+// Score represents a player best score in a game mode
+type Score struct {
+	ID        uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	PlayerID  uint      `json:"-" gorm:"uniqueIndex:idx_score_player_mode"`
+	Mode      string    `json:"mode" gorm:"size:50;uniqueIndex:idx_score_player_mode"`
+	Best      int       `json:"best"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewScore creates a new Score instance
+func NewScore(playerID uint, mode string, best int) *Score {
+	return &Score{
+		PlayerID: playerID,
+		Mode:     mode,
+		Best:     best,
+	}
+}
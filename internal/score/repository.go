@@ -0,0 +1,103 @@
+package score
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewScoreRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// /----- READ -----/
+
+// GetByPlayer retrieves a player score in a game mode
+func (r *Repository) GetByPlayer(playerID uint, mode string) (*Score, error) {
+
+	var score Score
+	result := r.db.Where("player_id = ? AND mode = ?", playerID, mode).First(&score)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("score not found")
+		}
+		return nil, result.Error
+	}
+
+	return &score, nil
+}
+
+// GetAllByPlayer retrieves a player scores in all game modes
+func (r *Repository) GetAllByPlayer(playerID uint) ([]Score, error) {
+
+	var scores []Score
+	result := r.db.Where("player_id = ?", playerID).Order("mode").Find(&scores)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return scores, nil
+}
+
+// /----- UPDATE -----/
+
+// Save creates or updates a score record in the database
+func (r *Repository) Save(score *Score) error {
+
+	if score == nil {
+		return errors.New("score cannot be nil")
+	}
+
+	result := r.db.Save(score)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// MergePlayer moves player from scores to player to, keeping the best score in a same mode
+func (r *Repository) MergePlayer(from uint, to uint) error {
+
+	var scores []Score
+	if err := r.db.Where("player_id = ?", from).Find(&scores).Error; err != nil {
+		return err
+	}
+
+	for _, score := range scores {
+
+		kept, err := r.GetByPlayer(to, score.Mode)
+		if err != nil {
+			if err := r.db.Model(&score).Update("player_id", to).Error; err != nil {
+				return err
+			}
+			continue
+		}
+
+		if score.Best > kept.Best {
+			kept.Best = score.Best
+			if err := r.Save(kept); err != nil {
+				return err
+			}
+		}
+
+		if err := r.db.Delete(&score).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// /----- DELETE -----/
+
+// DeleteByPlayer deletes a player scores
+func (r *Repository) DeleteByPlayer(playerID uint) error {
+	return r.db.Where("player_id = ?", playerID).Delete(&Score{}).Error
+}
@@ -0,0 +1,52 @@
+package score
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Service handles players best scores
+type Service struct {
+	repo *Repository
+}
+
+// NewScoreService creates a new score service
+func NewScoreService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// GetBest returns player best score in a game mode, 0 if never played
+func (s *Service) GetBest(playerID uint, mode string) int {
+
+	score, err := s.repo.GetByPlayer(playerID, mode)
+	if err != nil {
+		return 0
+	}
+
+	return score.Best
+}
+
+// SubmitScore saves player score in a game mode if better than his best one,
+// and returns the best score.
+func (s *Service) SubmitScore(playerID uint, mode string, value int) (int, error) {
+
+	if playerID == 0 {
+		return 0, errors.New("invalid player ID")
+	}
+
+	score, err := s.repo.GetByPlayer(playerID, mode)
+	if err != nil {
+		score = NewScore(playerID, mode, 0)
+	}
+
+	if value <= score.Best {
+		return score.Best, nil
+	}
+
+	score.Best = value
+	if err := s.repo.Save(score); err != nil {
+		return 0, fmt.Errorf("failed to save score: %w", err)
+	}
+
+	return score.Best, nil
+}
@@ -0,0 +1,95 @@
+package stats
+
+import "time"
+
+// Result represents a finished puzzle of a player
+type Result struct {
+	ID          uint      `json:"id" gorm:"primaryKey;autoIncrement"`
+	PlayerID    uint      `json:"-" gorm:"uniqueIndex:idx_result_player_puzzle"`
+	Puzzle      string    `json:"puzzle" gorm:"size:100;uniqueIndex:idx_result_player_puzzle"`
+	Mode        string    `json:"mode" gorm:"size:50;index"`
+	Number      int       `json:"number" gorm:"index"` // Daily puzzle number
+	Won         bool      `json:"won"`
+	Guesses     int       `json:"guesses"`
+	Practice    bool      `json:"practice"`                    // Archive, challenge or practice play, not counted
+	CharacterID uint      `json:"-" gorm:"index"`              // Puzzle answer, if any
+	Grid        []string  `json:"grid" gorm:"serializer:json"` // Spoiler-free result grid rows
+	Streak      int       `json:"streak"`                      // Current streak after this result
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at" gorm:"autoCreateTime"`
+}
+
+// Stat represents a player statistics in a game mode, updated with each result
+type Stat struct {
+	ID            uint        `json:"-" gorm:"primaryKey;autoIncrement"`
+	PlayerID      uint        `json:"-" gorm:"uniqueIndex:idx_stat_player_mode"`
+	Mode          string      `json:"mode" gorm:"size:50;uniqueIndex:idx_stat_player_mode"`
+	Played        int         `json:"played"`
+	Won           int         `json:"won"`
+	CurrentStreak int         `json:"current_streak"`
+	MaxStreak     int         `json:"max_streak"`
+	Distribution  map[int]int `json:"distribution" gorm:"serializer:json"` // Won games count by guesses count
+	LastNumber    int         `json:"-"`                                   // Last counted puzzle number
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// StatView represents a player statistics in a game mode, as shown to him
+type StatView struct {
+	Stat
+	WinPercentage int `json:"win_percentage"`
+}
+
+// NewStat creates a new Stat instance
+func NewStat(playerID uint, mode string) *Stat {
+	return &Stat{
+		PlayerID:     playerID,
+		Mode:         mode,
+		Distribution: make(map[int]int),
+	}
+}
+
+// Add counts result in statistics. Streak goes on with consecutive puzzle numbers,
+// so it only depends on the puzzle day and not on the player timezone.
+func (s *Stat) Add(result *Result) {
+
+	// Puzzle already counted or played out of order
+	if result.Practice || result.Number <= s.LastNumber {
+		return
+	}
+
+	s.Played++
+
+	if result.Won {
+		s.Won++
+		s.Distribution[result.Guesses]++
+
+		if s.CurrentStreak > 0 && result.Number == s.LastNumber+1 {
+			s.CurrentStreak++
+		} else {
+			s.CurrentStreak = 1
+		}
+
+		s.MaxStreak = max(s.MaxStreak, s.CurrentStreak)
+	} else {
+		s.CurrentStreak = 0
+	}
+
+	s.LastNumber = result.Number
+}
+
+// View returns statistics as shown at puzzle number today,
+// current streak is lost when yesterday puzzle has been missed.
+func (s *Stat) View(today int) StatView {
+
+	view := StatView{Stat: *s}
+
+	if s.LastNumber < today-1 {
+		view.CurrentStreak = 0
+	}
+
+	if s.Played > 0 {
+		view.WinPercentage = s.Won * 100 / s.Played
+	}
+
+	return view
+}
@@ -0,0 +1,152 @@
+package stats
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewStatsRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// /----- CREATE -----/
+
+// AddResult creates a new result record in the database,
+// returns false if player already finished this puzzle.
+func (r *Repository) AddResult(result *Result) (bool, error) {
+
+	created := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(result)
+	if created.Error != nil {
+		return false, created.Error
+	}
+
+	return created.RowsAffected > 0, nil
+}
+
+// /----- READ -----/
+
+// GetResultByID retrieves a result by its ID
+func (r *Repository) GetResultByID(id uint) (*Result, error) {
+
+	var result Result
+	query := r.db.First(&result, id)
+
+	if query.Error != nil {
+		if errors.Is(query.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrResultNotFound
+		}
+		return nil, query.Error
+	}
+
+	return &result, nil
+}
+
+// GetResult retrieves a player result on a puzzle
+func (r *Repository) GetResult(playerID uint, puzzle string) (*Result, error) {
+
+	var result Result
+	query := r.db.Where("player_id = ? AND puzzle = ?", playerID, puzzle).First(&result)
+
+	if query.Error != nil {
+		if errors.Is(query.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrResultNotFound
+		}
+		return nil, query.Error
+	}
+
+	return &result, nil
+}
+
+// GetResults retrieves a player results in puzzle number order
+func (r *Repository) GetResults(playerID uint) ([]Result, error) {
+
+	var results []Result
+	result := r.db.Where("player_id = ?", playerID).Order("number, finished_at").Find(&results)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return results, nil
+}
+
+// GetStat retrieves a player statistics in a game mode
+func (r *Repository) GetStat(playerID uint, mode string) (*Stat, error) {
+
+	var stat Stat
+	result := r.db.Where("player_id = ? AND mode = ?", playerID, mode).First(&stat)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.New("stat not found")
+		}
+		return nil, result.Error
+	}
+
+	return &stat, nil
+}
+
+// GetStats retrieves a player statistics in all game modes
+func (r *Repository) GetStats(playerID uint) ([]Stat, error) {
+
+	var stats []Stat
+	result := r.db.Where("player_id = ?", playerID).Order("mode").Find(&stats)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return stats, nil
+}
+
+// /----- UPDATE -----/
+
+// SaveStat creates or updates a stat record in the database
+func (r *Repository) SaveStat(stat *Stat) error {
+
+	if stat == nil {
+		return errors.New("stat cannot be nil")
+	}
+
+	result := r.db.Save(stat)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// MergePlayer moves player from results to player to, whose result is kept on a same puzzle.
+// Statistics of both players are deleted, to be rebuilt from results.
+func (r *Repository) MergePlayer(from uint, to uint) error {
+
+	kept := r.db.Model(&Result{}).Select("puzzle").Where("player_id = ?", to)
+
+	if err := r.db.Where("player_id = ? AND puzzle IN (?)", from, kept).Delete(&Result{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(&Result{}).Where("player_id = ?", from).Update("player_id", to).Error; err != nil {
+		return err
+	}
+
+	return r.db.Where("player_id IN ?", []uint{from, to}).Delete(&Stat{}).Error
+}
+
+// /----- DELETE -----/
+
+// DeleteByPlayer deletes a player results and statistics
+func (r *Repository) DeleteByPlayer(playerID uint) error {
+
+	if err := r.db.Where("player_id = ?", playerID).Delete(&Result{}).Error; err != nil {
+		return err
+	}
+
+	return r.db.Where("player_id = ?", playerID).Delete(&Stat{}).Error
+}
@@ -0,0 +1,128 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Service handles players results and statistics
+type Service struct {
+	repo *Repository
+}
+
+// NewStatsService creates a new stats service
+func NewStatsService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Record saves a finished puzzle result and updates player statistics of its mode.
+// A puzzle is only recorded once per player, with the streak it led to.
+func (s *Service) Record(result *Result) error {
+
+	if result.PlayerID == 0 {
+		return errors.New("invalid player ID")
+	}
+
+	stat, err := s.repo.GetStat(result.PlayerID, result.Mode)
+	if err != nil {
+		stat = NewStat(result.PlayerID, result.Mode)
+	}
+
+	stat.Add(result)
+	result.Streak = stat.CurrentStreak
+
+	added, err := s.repo.AddResult(result)
+	if err != nil {
+		return fmt.Errorf("failed to save result: %w", err)
+	}
+
+	if !added || result.Practice {
+		return nil
+	}
+
+	if err := s.repo.SaveStat(stat); err != nil {
+		return fmt.Errorf("failed to save stat: %w", err)
+	}
+
+	return nil
+}
+
+// Rebuild recomputes player statistics in all modes from his results
+func (s *Service) Rebuild(playerID uint) error {
+
+	results, err := s.repo.GetResults(playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get results: %w", err)
+	}
+
+	stats := make(map[string]*Stat)
+	for i := range results {
+
+		result := &results[i]
+		if result.Practice {
+			continue
+		}
+
+		if stats[result.Mode] == nil {
+			stats[result.Mode] = NewStat(playerID, result.Mode)
+		}
+		stats[result.Mode].Add(result)
+	}
+
+	for _, stat := range stats {
+		if err := s.repo.SaveStat(stat); err != nil {
+			return fmt.Errorf("failed to save stat: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetResult returns a result by its ID
+func (s *Service) GetResult(id uint) (*Result, error) {
+
+	result, err := s.repo.GetResultByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get result: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetPuzzleResult returns player result on a puzzle
+func (s *Service) GetPuzzleResult(playerID uint, puzzle string) (*Result, error) {
+
+	result, err := s.repo.GetResult(playerID, puzzle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get result: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetResults returns player finished puzzles results, in puzzle number order
+func (s *Service) GetResults(playerID uint) ([]Result, error) {
+
+	results, err := s.repo.GetResults(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get results: %w", err)
+	}
+
+	return results, nil
+}
+
+// GetStats returns player statistics in all played modes, as shown at puzzle number today.
+func (s *Service) GetStats(playerID uint, today int) ([]StatView, error) {
+
+	stats, err := s.repo.GetStats(playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats: %w", err)
+	}
+
+	views := make([]StatView, 0, len(stats))
+	for _, stat := range stats {
+		views = append(views, stat.View(today))
+	}
+
+	return views, nil
+}
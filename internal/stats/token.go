@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/doruo/falloutdle/pkg/secret"
+)
+
+var (
+	ErrInvalidToken   = errors.New("invalid result token")
+	ErrResultNotFound = errors.New("result not found")
+)
+
+// Signature length kept in tokens, in bytes
+const signatureLength = 12
+
+// ResultSigner signs results IDs into stable tokens, so share cards URLs can not be guessed
+type ResultSigner struct {
+	key []byte
+}
+
+// NewResultSigner creates a new ResultSigner from key
+func NewResultSigner(key []byte) *ResultSigner {
+	return &ResultSigner{key: key}
+}
+
+// NewDefaultResultSigner creates a new ResultSigner with key from SHARE_SECRET environment variable
+func NewDefaultResultSigner() *ResultSigner {
+	return NewResultSigner(secret.Key("SHARE_SECRET"))
+}
+
+// Sign returns a token holding result ID and its signature, always the same for an ID.
+func (rs *ResultSigner) Sign(id uint) string {
+	value := strconv.FormatUint(uint64(id), 10)
+	return value + "-" + rs.signature(value)
+}
+
+// Verify returns result ID from token, fails if signature does not match.
+func (rs *ResultSigner) Verify(token string) (uint, error) {
+
+	value, signature, found := strings.Cut(token, "-")
+	if !found {
+		return 0, ErrInvalidToken
+	}
+
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || id == 0 {
+		return 0, ErrInvalidToken
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(rs.signature(value))) {
+		return 0, ErrInvalidToken
+	}
+
+	return uint(id), nil
+}
+
+// signature returns value truncated HMAC-SHA256, base64 encoded.
+func (rs *ResultSigner) signature(value string) string {
+	mac := hmac.New(sha256.New, rs.key)
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureLength])
+}
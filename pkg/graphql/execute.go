@@ -0,0 +1,471 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Error represents a GraphQL request or field error
+type Error struct {
+	Message   string     `json:"message"`
+	Locations []Location `json:"locations,omitempty"`
+	Path      []any      `json:"path,omitempty"` // Response keys and list indexes of the failed field
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Request represents a GraphQL request, as sent in a POST JSON body
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Result represents a GraphQL response. Data is omitted if the request could not be executed.
+type Result struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []*Error        `json:"errors,omitempty"`
+}
+
+// NewSchema creates a new schema from its query root type.
+func NewSchema(query *Object) *Schema {
+
+	types := make(map[string]Type)
+	collectTypes(query, types)
+
+	return &Schema{Query: query, types: types}
+}
+
+// Execute parses, validates and executes a query request. Only query operations are supported.
+func (s *Schema) Execute(ctx context.Context, request Request) *Result {
+
+	document, err := Parse(request.Query)
+	if err != nil {
+		return requestError(err)
+	}
+
+	operation, err := document.operation(request.OperationName)
+	if err != nil {
+		return requestError(err)
+	}
+
+	if operation.Type != "query" {
+		return requestError(&Error{Message: "Only query operations are supported", Locations: []Location{operation.Location}})
+	}
+
+	if errs := s.validate(document, operation); len(errs) > 0 {
+		return &Result{Errors: errs}
+	}
+
+	variables, err := s.coerceVariables(operation, request.Variables)
+	if err != nil {
+		return requestError(err)
+	}
+
+	e := &executor{ctx: ctx, document: document, variables: variables}
+	data, _ := e.selectionSet(s.Query, nil, operation.Selections, nil)
+
+	var encoded bytes.Buffer
+	if data == nil {
+		encoded.WriteString("null")
+	} else if err := json.NewEncoder(&encoded).Encode(data); err != nil {
+		return requestError(err)
+	}
+
+	return &Result{Data: bytes.TrimSpace(encoded.Bytes()), Errors: e.errors}
+}
+
+// requestError returns the result of a request that could not be executed.
+func requestError(err error) *Result {
+
+	graphqlErr, ok := err.(*Error)
+	if !ok {
+		graphqlErr = &Error{Message: err.Error()}
+	}
+
+	return &Result{Errors: []*Error{graphqlErr}}
+}
+
+// operation returns document operation to execute, the only one if name is empty.
+func (d *Document) operation(name string) (*Operation, error) {
+
+	if name == "" {
+		if len(d.Operations) > 1 {
+			return nil, &Error{Message: "Must provide operation name if query contains multiple operations"}
+		}
+		return d.Operations[0], nil
+	}
+
+	for _, operation := range d.Operations {
+		if operation.Name == name {
+			return operation, nil
+		}
+	}
+
+	return nil, &Error{Message: fmt.Sprintf("Unknown operation named %q", name)}
+}
+
+// coerceVariables returns operation variables values coerced to their types, with defaults.
+func (s *Schema) coerceVariables(operation *Operation, values map[string]any) (map[string]any, error) {
+
+	variables := make(map[string]any)
+
+	for _, definition := range operation.Variables {
+
+		t, err := s.inputType(definition.Type)
+		if err != nil {
+			return nil, &Error{Message: fmt.Sprintf("Variable $%s: %s", definition.Name, err), Locations: []Location{definition.Location}}
+		}
+
+		value, given := values[definition.Name]
+		if !given {
+			if definition.Default == nil {
+				if _, nonNull := t.(*NonNull); nonNull {
+					return nil, &Error{Message: fmt.Sprintf("Variable $%s of type %s is required", definition.Name, t), Locations: []Location{definition.Location}}
+				}
+				continue
+			}
+			value = definition.Default
+		}
+
+		coerced, err := coerceInput(value, t, nil)
+		if err != nil {
+			return nil, &Error{Message: fmt.Sprintf("Variable $%s: %s", definition.Name, err), Locations: []Location{definition.Location}}
+		}
+		variables[definition.Name] = coerced
+	}
+
+	return variables, nil
+}
+
+// inputType returns schema input type of a document type reference.
+func (s *Schema) inputType(ref *TypeRef) (Type, error) {
+
+	var t Type
+
+	if ref.Of != nil {
+		of, err := s.inputType(ref.Of)
+		if err != nil {
+			return nil, err
+		}
+		t = &List{Of: of}
+	} else {
+		named, exists := s.types[ref.Name]
+		if !exists {
+			return nil, fmt.Errorf("unknown type %s", ref.Name)
+		}
+		if _, isObject := named.(*Object); isObject {
+			return nil, fmt.Errorf("%s is not an input type", ref.Name)
+		}
+		t = named
+	}
+
+	if ref.NonNull {
+		t = &NonNull{Of: t}
+	}
+
+	return t, nil
+}
+
+// /----- EXECUTION -----/
+
+// executor executes an operation selections, collecting field errors
+type executor struct {
+	ctx       context.Context
+	document  *Document
+	variables map[string]any
+	errors    []*Error
+}
+
+// collectedField represents the fields selected under a same response key
+type collectedField struct {
+	key    string
+	fields []*SelectedField
+}
+
+// selectionSet returns object fields values selected on source. Reports if a non-null field failed,
+// so that object must be null too.
+func (e *executor) selectionSet(object *Object, source any, selections []Selection, path []any) (*orderedMap, bool) {
+
+	result := &orderedMap{values: make(map[string]any)}
+
+	for _, collected := range e.collectFields(object, selections, nil, nil) {
+
+		field := collected.fields[0]
+		fieldPath := append(path[:len(path):len(path)], collected.key)
+
+		if field.Name == "__typename" {
+			result.set(collected.key, object.Name)
+			continue
+		}
+
+		definition := object.Field(field.Name)
+		value, failed := e.field(definition, source, collected.fields, fieldPath)
+
+		if failed {
+			if _, nonNull := definition.Type.(*NonNull); nonNull {
+				return nil, true
+			}
+			value = nil
+		}
+
+		result.set(collected.key, value)
+	}
+
+	return result, false
+}
+
+// collectFields returns selected fields of object by response key, in selection order.
+// Fragments applying to object are merged, skipped selections are left out.
+func (e *executor) collectFields(object *Object, selections []Selection, collected []*collectedField, visited map[string]bool) []*collectedField {
+
+	for _, selection := range selections {
+
+		switch selection := selection.(type) {
+
+		case *SelectedField:
+			if !e.included(selection.Directives) {
+				continue
+			}
+
+			key := selection.ResponseKey()
+			found := false
+			for _, c := range collected {
+				if c.key == key {
+					c.fields = append(c.fields, selection)
+					found = true
+					break
+				}
+			}
+			if !found {
+				collected = append(collected, &collectedField{key: key, fields: []*SelectedField{selection}})
+			}
+
+		case *FragmentSpread:
+			if !e.included(selection.Directives) || visited[selection.Name] {
+				continue
+			}
+
+			fragment := e.document.Fragments[selection.Name]
+			if fragment == nil || fragment.TypeCondition != object.Name {
+				continue
+			}
+
+			if visited == nil {
+				visited = make(map[string]bool)
+			}
+			visited[selection.Name] = true
+			collected = e.collectFields(object, fragment.Selections, collected, visited)
+
+		case *InlineFragment:
+			if !e.included(selection.Directives) || (selection.TypeCondition != "" && selection.TypeCondition != object.Name) {
+				continue
+			}
+			collected = e.collectFields(object, selection.Selections, collected, visited)
+		}
+	}
+
+	return collected
+}
+
+// included determines if a selection is included by its @skip and @include directives.
+func (e *executor) included(directives []*Directive) bool {
+
+	for _, directive := range directives {
+
+		condition, _ := coerceInput(directive.Arguments["if"], &NonNull{Of: Boolean}, e.variables)
+
+		switch directive.Name {
+		case "skip":
+			if condition == true {
+				return false
+			}
+		case "include":
+			if condition != true {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// field resolves and completes a field value on source. Reports if the field failed.
+func (e *executor) field(definition *Field, source any, fields []*SelectedField, path []any) (value any, failed bool) {
+
+	field := fields[0]
+
+	defer func() {
+		if r := recover(); r != nil {
+			e.fail(field, path, fmt.Errorf("internal error resolving %s", field.Name))
+			value, failed = nil, true
+		}
+	}()
+
+	args, err := coerceArguments(field.Arguments, definition.Args, e.variables)
+	if err != nil {
+		e.fail(field, path, err)
+		return nil, true
+	}
+
+	if definition.Resolve != nil {
+		value, err = definition.Resolve(ResolveParams{Context: e.ctx, Source: source, Args: args})
+	} else if object, ok := source.(map[string]any); ok {
+		value = object[definition.Name]
+	}
+
+	if err != nil {
+		e.fail(field, path, err)
+		return nil, true
+	}
+
+	return e.complete(definition.Type, fields, value, path)
+}
+
+// complete returns a resolved value serialized as its type. Reports if value must be null due to an error.
+func (e *executor) complete(t Type, fields []*SelectedField, value any, path []any) (any, bool) {
+
+	if nonNull, ok := t.(*NonNull); ok {
+
+		completed, failed := e.complete(nonNull.Of, fields, value, path)
+		if failed {
+			return nil, true
+		}
+
+		if completed == nil {
+			e.fail(fields[0], path, fmt.Errorf("cannot return null for non-nullable field %s", fields[0].Name))
+			return nil, true
+		}
+
+		return completed, false
+	}
+
+	if isNil(value) {
+		return nil, false
+	}
+
+	switch t := t.(type) {
+
+	case *List:
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+			e.fail(fields[0], path, fmt.Errorf("expected a list for field %s", fields[0].Name))
+			return nil, true
+		}
+
+		list := make([]any, 0, items.Len())
+		for i := range items.Len() {
+
+			item, failed := e.complete(t.Of, fields, items.Index(i).Interface(), append(path[:len(path):len(path)], i))
+			if failed {
+				if _, nonNull := t.Of.(*NonNull); nonNull {
+					return nil, true
+				}
+				item = nil
+			}
+
+			list = append(list, item)
+		}
+
+		return list, false
+
+	case *Scalar:
+		serialized, err := t.Serialize(value)
+		if err != nil {
+			e.fail(fields[0], path, err)
+			return nil, true
+		}
+		return serialized, false
+
+	case *Enum:
+		name, err := serializeString(value)
+		if err != nil || !t.HasValue(name.(string)) {
+			e.fail(fields[0], path, fmt.Errorf("%s cannot represent %v", t.Name, value))
+			return nil, true
+		}
+		return name, false
+
+	case *Object:
+		var selections []Selection
+		for _, field := range fields {
+			selections = append(selections, field.Selections...)
+		}
+
+		object, failed := e.selectionSet(t, value, selections, path)
+		if failed {
+			return nil, true
+		}
+		return object, false
+	}
+
+	e.fail(fields[0], path, fmt.Errorf("%s is not an output type", t))
+	return nil, true
+}
+
+// fail adds a field error at path.
+func (e *executor) fail(field *SelectedField, path []any, err error) {
+	e.errors = append(e.errors, &Error{
+		Message:   err.Error(),
+		Locations: []Location{field.Location},
+		Path:      path,
+	})
+}
+
+// isNil determines if value is nil, or a nil pointer, slice or map.
+func isNil(value any) bool {
+
+	if value == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// orderedMap represents a response object, encoded with its keys in selection order
+type orderedMap struct {
+	keys   []string
+	values map[string]any
+}
+
+func (m *orderedMap) set(key string, value any) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+
+	for i, key := range m.keys {
+
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		name, _ := json.Marshal(key)
+		value, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
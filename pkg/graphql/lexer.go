@@ -0,0 +1,295 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// tokenKind represents a lexical token kind of a GraphQL document
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+// token represents a lexical token, with its position for errors
+type token struct {
+	kind     tokenKind
+	value    string // Punctuator, name, number source or decoded string
+	location Location
+}
+
+// Location represents a position in a GraphQL document, from 1
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// lexer splits a GraphQL document into tokens
+type lexer struct {
+	source string
+	offset int
+	line   int
+	start  int // Offset of current line start
+}
+
+func newLexer(source string) *lexer {
+	return &lexer{source: source, line: 1}
+}
+
+// next returns next token, skipping ignored whitespaces, commas and comments.
+func (l *lexer) next() (token, error) {
+
+	l.skipIgnored()
+
+	location := Location{Line: l.line, Column: l.offset - l.start + 1}
+	if l.offset >= len(l.source) {
+		return token{kind: tokenEOF, location: location}, nil
+	}
+
+	c := l.source[l.offset]
+
+	switch {
+	case strings.HasPrefix(l.source[l.offset:], "..."):
+		l.offset += 3
+		return token{kind: tokenPunctuator, value: "...", location: location}, nil
+
+	case strings.IndexByte("!$():=@[]{}|&", c) >= 0:
+		l.offset++
+		return token{kind: tokenPunctuator, value: string(c), location: location}, nil
+
+	case c == '_' || isLetter(c):
+		start := l.offset
+		for l.offset < len(l.source) && (l.source[l.offset] == '_' || isLetter(l.source[l.offset]) || isDigit(l.source[l.offset])) {
+			l.offset++
+		}
+		return token{kind: tokenName, value: l.source[start:l.offset], location: location}, nil
+
+	case c == '-' || isDigit(c):
+		return l.number(location)
+
+	case c == '"':
+		value, err := l.string(location)
+		return token{kind: tokenString, value: value, location: location}, err
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.source[l.offset:])
+	return token{}, syntaxError(location, "unexpected character %q", r)
+}
+
+// skipIgnored skips whitespaces, line terminators, commas and comments.
+func (l *lexer) skipIgnored() {
+
+	for l.offset < len(l.source) {
+
+		switch c := l.source[l.offset]; c {
+		case ' ', '\t', ',', '\r':
+			l.offset++
+		case '\n':
+			l.offset++
+			l.line++
+			l.start = l.offset
+		case '#':
+			for l.offset < len(l.source) && l.source[l.offset] != '\n' {
+				l.offset++
+			}
+		default:
+			// Unicode byte order mark
+			if strings.HasPrefix(l.source[l.offset:], "\uFEFF") {
+				l.offset += len("\uFEFF")
+				continue
+			}
+			return
+		}
+	}
+}
+
+// number reads an Int or Float token.
+func (l *lexer) number(location Location) (token, error) {
+
+	start := l.offset
+	kind := tokenInt
+
+	if l.source[l.offset] == '-' {
+		l.offset++
+	}
+
+	if !l.digits() {
+		return token{}, syntaxError(location, "invalid number")
+	}
+
+	if l.offset < len(l.source) && l.source[l.offset] == '.' {
+		kind = tokenFloat
+		l.offset++
+		if !l.digits() {
+			return token{}, syntaxError(location, "invalid number")
+		}
+	}
+
+	if l.offset < len(l.source) && (l.source[l.offset] == 'e' || l.source[l.offset] == 'E') {
+		kind = tokenFloat
+		l.offset++
+		if l.offset < len(l.source) && (l.source[l.offset] == '+' || l.source[l.offset] == '-') {
+			l.offset++
+		}
+		if !l.digits() {
+			return token{}, syntaxError(location, "invalid number")
+		}
+	}
+
+	return token{kind: kind, value: l.source[start:l.offset], location: location}, nil
+}
+
+// digits reads at least one digit.
+func (l *lexer) digits() bool {
+
+	start := l.offset
+	for l.offset < len(l.source) && isDigit(l.source[l.offset]) {
+		l.offset++
+	}
+
+	return l.offset > start
+}
+
+// string reads a quoted or block string token, and returns its decoded value.
+func (l *lexer) string(location Location) (string, error) {
+
+	// Block string, raw content with common indentation removed
+	if strings.HasPrefix(l.source[l.offset:], `"""`) {
+
+		// Closing quotes, not escaped as \"""
+		end := 0
+		for {
+			i := strings.Index(l.source[l.offset+3+end:], `"""`)
+			if i < 0 {
+				return "", syntaxError(location, "unterminated string")
+			}
+
+			end += i
+			if end == 0 || l.source[l.offset+3+end-1] != '\\' {
+				break
+			}
+			end += 3
+		}
+
+		raw := l.source[l.offset+3 : l.offset+3+end]
+		for _, c := range raw {
+			if c == '\n' {
+				l.line++
+			}
+		}
+		l.offset += 3 + end + 3
+		if i := strings.LastIndexByte(l.source[:l.offset], '\n'); i >= 0 {
+			l.start = i + 1
+		}
+
+		return blockString(raw), nil
+	}
+
+	var value strings.Builder
+	l.offset++
+
+	for l.offset < len(l.source) {
+
+		c := l.source[l.offset]
+		switch {
+		case c == '"':
+			l.offset++
+			return value.String(), nil
+
+		case c == '\n':
+			return "", syntaxError(location, "unterminated string")
+
+		case c == '\\':
+			if l.offset+1 >= len(l.source) {
+				return "", syntaxError(location, "unterminated string")
+			}
+
+			escape := l.source[l.offset+1]
+			l.offset += 2
+
+			switch escape {
+			case '"', '\\', '/':
+				value.WriteByte(escape)
+			case 'b':
+				value.WriteByte('\b')
+			case 'f':
+				value.WriteByte('\f')
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			case 'u':
+				if l.offset+4 > len(l.source) {
+					return "", syntaxError(location, "invalid unicode escape")
+				}
+				code, err := strconv.ParseUint(l.source[l.offset:l.offset+4], 16, 32)
+				if err != nil {
+					return "", syntaxError(location, "invalid unicode escape")
+				}
+				value.WriteRune(rune(code))
+				l.offset += 4
+			default:
+				return "", syntaxError(location, "invalid escape \\%c", escape)
+			}
+
+		default:
+			value.WriteByte(c)
+			l.offset++
+		}
+	}
+
+	return "", syntaxError(location, "unterminated string")
+}
+
+// blockString returns block string raw value without common indentation and blank first and last lines.
+func blockString(raw string) string {
+
+	lines := strings.Split(strings.ReplaceAll(raw, `\"""`, `"""`), "\n")
+
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed != "" && (indent < 0 || len(line)-len(trimmed) < indent) {
+			indent = len(line) - len(trimmed)
+		}
+	}
+
+	for i := 1; i < len(lines) && indent > 0; i++ {
+		lines[i] = lines[i][min(indent, len(lines[i])):]
+	}
+
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// syntaxError returns a document syntax error at location.
+func syntaxError(location Location, format string, args ...any) *Error {
+	return &Error{
+		Message:   "Syntax error: " + fmt.Sprintf(format, args...),
+		Locations: []Location{location},
+	}
+}
@@ -0,0 +1,622 @@
+package graphql
+
+import (
+	"strconv"
+)
+
+// Document represents a parsed GraphQL request document
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+// Operation represents a query or mutation of a document
+type Operation struct {
+	Type       string // "query", "mutation" or "subscription"
+	Name       string
+	Variables  []*VariableDefinition
+	Selections []Selection
+	Location   Location
+}
+
+// VariableDefinition represents an operation variable with its type and default value
+type VariableDefinition struct {
+	Name     string
+	Type     *TypeRef
+	Default  any // nil if none
+	Location Location
+}
+
+// TypeRef represents a type as written in a document, Rx: [String!]!
+type TypeRef struct {
+	Name    string   // Named type, empty for a list
+	Of      *TypeRef // List item type
+	NonNull bool
+}
+
+// Fragment represents a named fragment definition
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	Selections    []Selection
+	Location      Location
+}
+
+// Selection represents a SelectedField, FragmentSpread or InlineFragment
+type Selection interface {
+	selection()
+}
+
+// SelectedField represents a field selected in a document
+type SelectedField struct {
+	Alias      string
+	Name       string
+	Arguments  map[string]any // Literal values, with Variable and EnumValue
+	Directives []*Directive
+	Selections []Selection
+	Location   Location
+}
+
+// ResponseKey returns field alias, or name if none.
+func (f *SelectedField) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// FragmentSpread represents a named fragment selection
+type FragmentSpread struct {
+	Name       string
+	Directives []*Directive
+	Location   Location
+}
+
+// InlineFragment represents an inline fragment selection, with an optional type condition
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []*Directive
+	Selections    []Selection
+	Location      Location
+}
+
+func (*SelectedField) selection()  {}
+func (*FragmentSpread) selection() {}
+func (*InlineFragment) selection() {}
+
+// Directive represents a directive applied to a selection, Rx: @skip(if: $hidden)
+type Directive struct {
+	Name      string
+	Arguments map[string]any
+}
+
+// Variable represents a variable used as a literal value
+type Variable string
+
+// EnumValue represents an enum literal value
+type EnumValue string
+
+// parser builds a Document from lexer tokens
+type parser struct {
+	lexer *lexer
+	token token
+}
+
+// Parse parses a GraphQL request document.
+func Parse(source string) (*Document, error) {
+
+	p := &parser{lexer: newLexer(source)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	document := &Document{Fragments: make(map[string]*Fragment)}
+
+	for p.token.kind != tokenEOF {
+
+		switch {
+		case p.peek("{"):
+			location := p.token.location
+			selections, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			document.Operations = append(document.Operations, &Operation{Type: "query", Selections: selections, Location: location})
+
+		case p.peekName("query"), p.peekName("mutation"), p.peekName("subscription"):
+			operation, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			document.Operations = append(document.Operations, operation)
+
+		case p.peekName("fragment"):
+			fragment, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := document.Fragments[fragment.Name]; exists {
+				return nil, &Error{Message: "There can be only one fragment named " + fragment.Name, Locations: []Location{fragment.Location}}
+			}
+			document.Fragments[fragment.Name] = fragment
+
+		default:
+			return nil, p.unexpected()
+		}
+	}
+
+	if len(document.Operations) == 0 {
+		return nil, &Error{Message: "Document must contain an operation"}
+	}
+
+	return document, nil
+}
+
+// /----- TOKENS -----/
+
+// advance reads next token.
+func (p *parser) advance() error {
+	t, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.token = t
+	return nil
+}
+
+// peek determines if current token is punctuator.
+func (p *parser) peek(punctuator string) bool {
+	return p.token.kind == tokenPunctuator && p.token.value == punctuator
+}
+
+// peekName determines if current token is name keyword.
+func (p *parser) peekName(name string) bool {
+	return p.token.kind == tokenName && p.token.value == name
+}
+
+// skip reads punctuator if current token, reporting if it was.
+func (p *parser) skip(punctuator string) (bool, error) {
+	if !p.peek(punctuator) {
+		return false, nil
+	}
+	return true, p.advance()
+}
+
+// expect reads punctuator, failing if current token is another one.
+func (p *parser) expect(punctuator string) error {
+	if !p.peek(punctuator) {
+		return p.unexpected()
+	}
+	return p.advance()
+}
+
+// name reads a name token.
+func (p *parser) name() (string, error) {
+
+	if p.token.kind != tokenName {
+		return "", p.unexpected()
+	}
+
+	name := p.token.value
+	return name, p.advance()
+}
+
+// unexpected returns a syntax error at current token.
+func (p *parser) unexpected() error {
+
+	if p.token.kind == tokenEOF {
+		return syntaxError(p.token.location, "unexpected end of document")
+	}
+
+	return syntaxError(p.token.location, "unexpected %q", p.token.value)
+}
+
+// /----- DEFINITIONS -----/
+
+// operation reads an operation definition with its keyword.
+func (p *parser) operation() (*Operation, error) {
+
+	operation := &Operation{Type: p.token.value, Location: p.token.location}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.token.kind == tokenName {
+		operation.Name, _ = p.name()
+	}
+
+	if p.peek("(") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		for !p.peek(")") {
+			variable, err := p.variableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			operation.Variables = append(operation.Variables, variable)
+		}
+
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+
+	selections, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	operation.Selections = selections
+
+	return operation, nil
+}
+
+// variableDefinition reads $name: Type = default.
+func (p *parser) variableDefinition() (*VariableDefinition, error) {
+
+	variable := &VariableDefinition{Location: p.token.location}
+
+	if err := p.expect("$"); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if variable.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+
+	if variable.Type, err = p.typeRef(); err != nil {
+		return nil, err
+	}
+
+	if found, err := p.skip("="); err != nil {
+		return nil, err
+	} else if found {
+		if variable.Default, err = p.value(true); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+
+	return variable, nil
+}
+
+// typeRef reads a type reference.
+func (p *parser) typeRef() (*TypeRef, error) {
+
+	var ref *TypeRef
+
+	if found, err := p.skip("["); err != nil {
+		return nil, err
+	} else if found {
+		of, err := p.typeRef()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		ref = &TypeRef{Of: of}
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		ref = &TypeRef{Name: name}
+	}
+
+	found, err := p.skip("!")
+	ref.NonNull = found
+
+	return ref, err
+}
+
+// fragment reads a fragment definition with its keyword.
+func (p *parser) fragment() (*Fragment, error) {
+
+	fragment := &Fragment{Location: p.token.location}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if fragment.Name, err = p.name(); err != nil {
+		return nil, err
+	}
+
+	if fragment.Name == "on" || !p.peekName("on") {
+		return nil, p.unexpected()
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if fragment.TypeCondition, err = p.name(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+
+	if fragment.Selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+
+	return fragment, nil
+}
+
+// /----- SELECTIONS -----/
+
+// selectionSet reads { selections }.
+func (p *parser) selectionSet() ([]Selection, error) {
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var selections []Selection
+	for {
+		if found, err := p.skip("}"); err != nil {
+			return nil, err
+		} else if found {
+			break
+		}
+
+		selection, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+
+	if len(selections) == 0 {
+		return nil, syntaxError(p.token.location, "empty selection set")
+	}
+
+	return selections, nil
+}
+
+// selection reads a field, fragment spread or inline fragment.
+func (p *parser) selection() (Selection, error) {
+
+	location := p.token.location
+
+	if found, err := p.skip("..."); err != nil {
+		return nil, err
+	} else if !found {
+		return p.field()
+	}
+
+	// Named fragment spread
+	if p.token.kind == tokenName && p.token.value != "on" {
+		name, _ := p.name()
+		directives, err := p.directives()
+		return &FragmentSpread{Name: name, Directives: directives, Location: location}, err
+	}
+
+	inline := &InlineFragment{Location: location}
+
+	if p.peekName("on") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if inline.TypeCondition, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if inline.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+
+	if inline.Selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+
+	return inline, nil
+}
+
+// field reads alias: name(arguments) @directives { selections }.
+func (p *parser) field() (*SelectedField, error) {
+
+	field := &SelectedField{Location: p.token.location}
+
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+
+	if found, err := p.skip(":"); err != nil {
+		return nil, err
+	} else if found {
+		field.Alias = name
+		if name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	field.Name = name
+
+	if field.Arguments, err = p.arguments(false); err != nil {
+		return nil, err
+	}
+
+	if field.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+
+	if p.peek("{") {
+		if field.Selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+
+	return field, nil
+}
+
+// arguments reads optional (name: value) arguments.
+func (p *parser) arguments(constant bool) (map[string]any, error) {
+
+	if found, err := p.skip("("); err != nil || !found {
+		return nil, err
+	}
+
+	arguments := make(map[string]any)
+
+	for {
+		if found, err := p.skip(")"); err != nil {
+			return nil, err
+		} else if found {
+			break
+		}
+
+		location := p.token.location
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, exists := arguments[name]; exists {
+			return nil, &Error{Message: "There can be only one argument named " + name, Locations: []Location{location}}
+		}
+
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		if arguments[name], err = p.value(constant); err != nil {
+			return nil, err
+		}
+	}
+
+	return arguments, nil
+}
+
+// directives reads optional @name(arguments) directives.
+func (p *parser) directives() ([]*Directive, error) {
+
+	var directives []*Directive
+
+	for p.peek("@") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+
+		arguments, err := p.arguments(false)
+		if err != nil {
+			return nil, err
+		}
+
+		directives = append(directives, &Directive{Name: name, Arguments: arguments})
+	}
+
+	return directives, nil
+}
+
+// /----- VALUES -----/
+
+// value reads a literal value, variables are not allowed if constant.
+// Integers are int64, floats float64, lists []any and objects map[string]any.
+func (p *parser) value(constant bool) (any, error) {
+
+	t := p.token
+
+	switch {
+	case p.peek("$") && !constant:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		return Variable(name), err
+
+	case p.peek("["):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		list := make([]any, 0)
+		for !p.peek("]") {
+			item, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+
+		return list, p.advance()
+
+	case p.peek("{"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		object := make(map[string]any)
+		for !p.peek("}") {
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			if object[name], err = p.value(constant); err != nil {
+				return nil, err
+			}
+		}
+
+		return object, p.advance()
+
+	case t.kind == tokenInt:
+		number, err := strconv.ParseInt(t.value, 10, 64)
+		if err != nil {
+			return nil, syntaxError(t.location, "invalid integer %s", t.value)
+		}
+		return number, p.advance()
+
+	case t.kind == tokenFloat:
+		number, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, syntaxError(t.location, "invalid float %s", t.value)
+		}
+		return number, p.advance()
+
+	case t.kind == tokenString:
+		return t.value, p.advance()
+
+	case t.kind == tokenName:
+		var value any
+		switch t.value {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null":
+			value = nil
+		default:
+			value = EnumValue(t.value)
+		}
+		return value, p.advance()
+	}
+
+	return nil, p.unexpected()
+}
@@ -0,0 +1,287 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Type represents a GraphQL type: *Scalar, *Enum, *Object, *InputObject, *List or *NonNull
+type Type interface {
+	String() string
+}
+
+// Scalar represents a leaf type, coerced from inputs and serialized to JSON
+type Scalar struct {
+	Name        string
+	Description string
+	Serialize   func(value any) (any, error) // Resolved value to JSON value
+	Parse       func(value any) (any, error) // Literal or variable JSON value to Go value
+}
+
+// Enum represents a leaf type of a closed set of values
+type Enum struct {
+	Name        string
+	Description string
+	Values      []string
+}
+
+// Object represents an output type with fields
+type Object struct {
+	Name        string
+	Description string
+	Fields      []*Field
+}
+
+// InputObject represents an argument type with fields
+type InputObject struct {
+	Name        string
+	Description string
+	Fields      []*Argument
+}
+
+// List represents a list of an item type
+type List struct {
+	Of Type
+}
+
+// NonNull represents a type that can not be null
+type NonNull struct {
+	Of Type
+}
+
+// Field represents an object field and how it is resolved
+type Field struct {
+	Name        string
+	Description string
+	Type        Type
+	Args        []*Argument
+	Resolve     ResolveFunc // Value of Name key if source is a map[string]any, if nil
+}
+
+// Argument represents a field argument or an input object field
+type Argument struct {
+	Name        string
+	Description string
+	Type        Type
+	Default     any // Coerced default value if not given, nil if none
+}
+
+// ResolveFunc returns a field value from its parent source value and arguments
+type ResolveFunc func(params ResolveParams) (any, error)
+
+// ResolveParams represents a field resolution context
+type ResolveParams struct {
+	Context context.Context
+	Source  any            // Parent object value
+	Args    map[string]any // Coerced arguments, with defaults
+}
+
+// Schema represents a GraphQL schema with its query root type, created with NewSchema
+type Schema struct {
+	Query *Object
+	types map[string]Type // Types reachable from query, by name
+}
+
+func (s *Scalar) String() string      { return s.Name }
+func (e *Enum) String() string        { return e.Name }
+func (o *Object) String() string      { return o.Name }
+func (o *InputObject) String() string { return o.Name }
+func (l *List) String() string        { return "[" + l.Of.String() + "]" }
+func (n *NonNull) String() string     { return n.Of.String() + "!" }
+
+// Field returns object field from its name, nil if none.
+func (o *Object) Field(name string) *Field {
+	for _, field := range o.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// HasValue determines if value is one of enum values.
+func (e *Enum) HasValue(value string) bool {
+	return slices.Contains(e.Values, value)
+}
+
+// /----- SCALARS -----/
+
+var (
+	Int = &Scalar{
+		Name:        "Int",
+		Description: "Signed 32 bit integer",
+		Serialize:   serializeInt,
+		Parse:       parseInt,
+	}
+	Float = &Scalar{
+		Name:        "Float",
+		Description: "Double precision floating point number",
+		Serialize:   serializeFloat,
+		Parse:       serializeFloat,
+	}
+	String = &Scalar{
+		Name:        "String",
+		Description: "UTF-8 text",
+		Serialize:   serializeString,
+		Parse:       parseString,
+	}
+	Boolean = &Scalar{
+		Name:        "Boolean",
+		Description: "true or false",
+		Serialize:   parseBoolean,
+		Parse:       parseBoolean,
+	}
+	ID = &Scalar{
+		Name:        "ID",
+		Description: "Unique identifier, serialized as a string",
+		Serialize:   serializeID,
+		Parse:       serializeID,
+	}
+)
+
+// /----- SCHEMA DEFINITION LANGUAGE -----/
+
+// String returns schema in GraphQL schema definition language, types by name.
+func (s *Schema) String() string {
+
+	names := make([]string, 0, len(s.types))
+	for name := range s.types {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var sdl strings.Builder
+	sdl.WriteString("schema {\n  query: " + s.Query.Name + "\n}\n")
+
+	for _, name := range names {
+
+		t := s.types[name]
+		if t == Int || t == Float || t == String || t == Boolean || t == ID {
+			continue
+		}
+
+		sdl.WriteString("\n")
+
+		switch t := t.(type) {
+		case *Scalar:
+			writeDescription(&sdl, "", t.Description)
+			sdl.WriteString("scalar " + t.Name + "\n")
+
+		case *Enum:
+			writeDescription(&sdl, "", t.Description)
+			sdl.WriteString("enum " + t.Name + " {\n")
+			for _, value := range t.Values {
+				sdl.WriteString("  " + value + "\n")
+			}
+			sdl.WriteString("}\n")
+
+		case *Object:
+			writeDescription(&sdl, "", t.Description)
+			sdl.WriteString("type " + t.Name + " {\n")
+			for _, field := range t.Fields {
+				writeDescription(&sdl, "  ", field.Description)
+				sdl.WriteString("  " + field.Name + writeArguments(field.Args) + ": " + field.Type.String() + "\n")
+			}
+			sdl.WriteString("}\n")
+
+		case *InputObject:
+			writeDescription(&sdl, "", t.Description)
+			sdl.WriteString("input " + t.Name + " {\n")
+			for _, field := range t.Fields {
+				writeDescription(&sdl, "  ", field.Description)
+				sdl.WriteString("  " + field.Name + ": " + field.Type.String() + writeDefault(field.Type, field.Default) + "\n")
+			}
+			sdl.WriteString("}\n")
+		}
+	}
+
+	return sdl.String()
+}
+
+// collectTypes adds t and types it references to types, by name.
+func collectTypes(t Type, types map[string]Type) {
+
+	switch t := t.(type) {
+	case *List:
+		collectTypes(t.Of, types)
+		return
+	case *NonNull:
+		collectTypes(t.Of, types)
+		return
+	}
+
+	if _, exists := types[t.String()]; exists {
+		return
+	}
+	types[t.String()] = t
+
+	switch t := t.(type) {
+	case *Object:
+		for _, field := range t.Fields {
+			collectTypes(field.Type, types)
+			for _, arg := range field.Args {
+				collectTypes(arg.Type, types)
+			}
+		}
+	case *InputObject:
+		for _, field := range t.Fields {
+			collectTypes(field.Type, types)
+		}
+	}
+}
+
+// writeDescription writes description as a string before a definition, if any.
+func writeDescription(sdl *strings.Builder, indent, description string) {
+	if description != "" {
+		sdl.WriteString(indent + fmt.Sprintf("%q", description) + "\n")
+	}
+}
+
+// writeArguments returns field arguments definition, empty if none.
+func writeArguments(args []*Argument) string {
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	definitions := make([]string, 0, len(args))
+	for _, arg := range args {
+		definitions = append(definitions, arg.Name+": "+arg.Type.String()+writeDefault(arg.Type, arg.Default))
+	}
+
+	return "(" + strings.Join(definitions, ", ") + ")"
+}
+
+// writeDefault returns " = value" of a default value of type t, empty if none.
+func writeDefault(t Type, value any) string {
+
+	if value == nil {
+		return ""
+	}
+
+	if _, isEnum := namedType(t).(*Enum); isEnum {
+		return fmt.Sprintf(" = %v", value)
+	}
+
+	if text, isString := value.(string); isString {
+		return fmt.Sprintf(" = %q", text)
+	}
+
+	return fmt.Sprintf(" = %v", value)
+}
+
+// namedType returns t without list and non-null wrappers.
+func namedType(t Type) Type {
+	for {
+		switch wrapper := t.(type) {
+		case *List:
+			t = wrapper.Of
+		case *NonNull:
+			t = wrapper.Of
+		default:
+			return t
+		}
+	}
+}
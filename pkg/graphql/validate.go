@@ -0,0 +1,181 @@
+package graphql
+
+import (
+	"fmt"
+)
+
+// Most nested fields levels of a query, nested objects queries are bounded
+const maxDepth = 10
+
+// validate returns errors of operation selections against schema, empty if valid.
+func (s *Schema) validate(document *Document, operation *Operation) []*Error {
+
+	v := &validator{document: document}
+	v.selections(s.Query, operation.Selections, 1, make(map[string]bool))
+
+	return v.errors
+}
+
+// validator checks selections against schema types, collecting errors
+type validator struct {
+	document *Document
+	errors   []*Error
+}
+
+// selections checks selections on parent object at depth, visiting fragments for cycles.
+func (v *validator) selections(parent *Object, selections []Selection, depth int, visiting map[string]bool) {
+
+	for _, selection := range selections {
+
+		switch selection := selection.(type) {
+
+		case *SelectedField:
+			v.directives(selection.Directives, selection.Location)
+			v.field(parent, selection, depth, visiting)
+
+		case *FragmentSpread:
+			v.directives(selection.Directives, selection.Location)
+
+			fragment := v.document.Fragments[selection.Name]
+			if fragment == nil {
+				v.fail(selection.Location, "Unknown fragment %q", selection.Name)
+				continue
+			}
+
+			if fragment.TypeCondition != parent.Name {
+				v.fail(selection.Location, "Fragment %q on %s cannot be spread on %s", selection.Name, fragment.TypeCondition, parent.Name)
+				continue
+			}
+
+			if visiting[selection.Name] {
+				v.fail(selection.Location, "Fragment %q spreads itself", selection.Name)
+				continue
+			}
+
+			visiting[selection.Name] = true
+			v.selections(parent, fragment.Selections, depth, visiting)
+			delete(visiting, selection.Name)
+
+		case *InlineFragment:
+			v.directives(selection.Directives, selection.Location)
+
+			if selection.TypeCondition != "" && selection.TypeCondition != parent.Name {
+				v.fail(selection.Location, "Fragment on %s cannot be spread on %s", selection.TypeCondition, parent.Name)
+				continue
+			}
+
+			v.selections(parent, selection.Selections, depth, visiting)
+		}
+	}
+}
+
+// field checks a field exists on parent with known arguments, and its sub selections.
+func (v *validator) field(parent *Object, field *SelectedField, depth int, visiting map[string]bool) {
+
+	if depth > maxDepth {
+		v.fail(field.Location, "Query is nested deeper than %d levels", maxDepth)
+		return
+	}
+
+	if field.Name == "__typename" {
+		if len(field.Selections) > 0 {
+			v.fail(field.Location, "Field __typename must not have a selection")
+		}
+		return
+	}
+
+	definition := parent.Field(field.Name)
+	if definition == nil {
+		v.fail(field.Location, "Cannot query field %q on type %q", field.Name, parent.Name)
+		return
+	}
+
+	for name, value := range field.Arguments {
+
+		arg := argument(definition.Args, name)
+		if arg == nil {
+			v.fail(field.Location, "Unknown argument %q on field %s.%s", name, parent.Name, field.Name)
+			continue
+		}
+
+		// Values holding variables are coerced once variables are known
+		if !hasVariable(value) {
+			if _, err := coerceInput(value, arg.Type, nil); err != nil {
+				v.fail(field.Location, "Argument %q on field %s.%s: %s", name, parent.Name, field.Name, err)
+			}
+		}
+	}
+
+	for _, arg := range definition.Args {
+		if _, nonNull := arg.Type.(*NonNull); nonNull && arg.Default == nil {
+			if _, given := field.Arguments[arg.Name]; !given {
+				v.fail(field.Location, "Field %s.%s argument %q of type %s is required", parent.Name, field.Name, arg.Name, arg.Type)
+			}
+		}
+	}
+
+	object, isObject := namedType(definition.Type).(*Object)
+
+	switch {
+	case isObject && len(field.Selections) == 0:
+		v.fail(field.Location, "Field %q of type %s must have a selection of subfields", field.Name, definition.Type)
+	case !isObject && len(field.Selections) > 0:
+		v.fail(field.Location, "Field %q of type %s must not have a selection", field.Name, definition.Type)
+	case isObject:
+		v.selections(object, field.Selections, depth+1, visiting)
+	}
+}
+
+// directives checks only @skip and @include directives are used, with their condition.
+func (v *validator) directives(directives []*Directive, location Location) {
+
+	for _, directive := range directives {
+
+		if directive.Name != "skip" && directive.Name != "include" {
+			v.fail(location, "Unknown directive @%s", directive.Name)
+			continue
+		}
+
+		if _, given := directive.Arguments["if"]; !given || len(directive.Arguments) != 1 {
+			v.fail(location, "Directive @%s expects a single if argument", directive.Name)
+		}
+	}
+}
+
+// fail adds a validation error at location.
+func (v *validator) fail(location Location, format string, args ...any) {
+	v.errors = append(v.errors, &Error{Message: fmt.Sprintf(format, args...), Locations: []Location{location}})
+}
+
+// argument returns definitions argument from its name, nil if none.
+func argument(definitions []*Argument, name string) *Argument {
+	for _, definition := range definitions {
+		if definition.Name == name {
+			return definition
+		}
+	}
+	return nil
+}
+
+// hasVariable determines if a literal value is or holds a variable.
+func hasVariable(value any) bool {
+
+	switch value := value.(type) {
+	case Variable:
+		return true
+	case []any:
+		for _, item := range value {
+			if hasVariable(item) {
+				return true
+			}
+		}
+	case map[string]any:
+		for _, field := range value {
+			if hasVariable(field) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,224 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// /----- SCALARS -----/
+
+// serializeInt returns any Go integer, or whole float, as a 32 bit int.
+func serializeInt(value any) (any, error) {
+
+	v := reflect.ValueOf(value)
+	var number float64
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		number = v.Float()
+	default:
+		return nil, fmt.Errorf("Int cannot represent %v", value)
+	}
+
+	if number != math.Trunc(number) || number < math.MinInt32 || number > math.MaxInt32 {
+		return nil, fmt.Errorf("Int cannot represent %v", value)
+	}
+
+	return int(number), nil
+}
+
+// parseInt returns an integer literal or JSON number as int.
+func parseInt(value any) (any, error) {
+
+	switch value.(type) {
+	case int64, float64:
+		return serializeInt(value)
+	}
+
+	return nil, fmt.Errorf("Int cannot represent %v", value)
+}
+
+// serializeFloat returns any Go number as float64.
+func serializeFloat(value any) (any, error) {
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+
+	return nil, fmt.Errorf("Float cannot represent %v", value)
+}
+
+// serializeString returns a string, string based type or Stringer as string.
+func serializeString(value any) (any, error) {
+
+	if stringer, ok := value.(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+
+	return nil, fmt.Errorf("String cannot represent %v", value)
+}
+
+// parseString returns a string literal or JSON string.
+func parseString(value any) (any, error) {
+
+	if text, ok := value.(string); ok {
+		return text, nil
+	}
+
+	return nil, fmt.Errorf("String cannot represent %v", value)
+}
+
+// parseBoolean returns a boolean.
+func parseBoolean(value any) (any, error) {
+
+	if boolean, ok := value.(bool); ok {
+		return boolean, nil
+	}
+
+	return nil, fmt.Errorf("Boolean cannot represent %v", value)
+}
+
+// serializeID returns a string or integer identifier as string.
+func serializeID(value any) (any, error) {
+
+	if number, err := serializeInt(value); err == nil {
+		return fmt.Sprint(number), nil
+	}
+
+	return serializeString(value)
+}
+
+// /----- INPUT COERCION -----/
+
+// coerceInput returns value coerced to input type t. Literal values may hold variables,
+// replaced by their values already coerced to their declared type.
+func coerceInput(value any, t Type, variables map[string]any) (any, error) {
+
+	if variable, ok := value.(Variable); ok {
+
+		value = variables[string(variable)]
+		if _, nonNull := t.(*NonNull); nonNull && value == nil {
+			return nil, fmt.Errorf("expected non-null %s, variable $%s is null", t, variable)
+		}
+
+		return value, nil
+	}
+
+	if nonNull, ok := t.(*NonNull); ok {
+		if value == nil {
+			return nil, fmt.Errorf("expected non-null %s", t)
+		}
+		return coerceInput(value, nonNull.Of, variables)
+	}
+
+	if value == nil {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+
+	case *List:
+		items, ok := value.([]any)
+		if !ok {
+			// A single value is a list of one item
+			items = []any{value}
+		}
+
+		list := make([]any, 0, len(items))
+		for _, item := range items {
+			coerced, err := coerceInput(item, t.Of, variables)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, coerced)
+		}
+		return list, nil
+
+	case *Scalar:
+		return t.Parse(value)
+
+	case *Enum:
+		var name string
+		switch value := value.(type) {
+		case EnumValue:
+			name = string(value)
+		case string:
+			name = value
+		}
+
+		if !t.HasValue(name) {
+			return nil, fmt.Errorf("%s cannot represent %v", t.Name, value)
+		}
+		return name, nil
+
+	case *InputObject:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", t.Name)
+		}
+		return coerceArguments(object, t.Fields, variables)
+	}
+
+	return nil, fmt.Errorf("%s is not an input type", t)
+}
+
+// coerceArguments returns values of definitions coerced with their defaults,
+// failing on unknown or missing required values.
+func coerceArguments(values map[string]any, definitions []*Argument, variables map[string]any) (map[string]any, error) {
+
+	for name := range values {
+		if !slices.ContainsFunc(definitions, func(definition *Argument) bool { return definition.Name == name }) {
+			return nil, fmt.Errorf("unknown argument %s", name)
+		}
+	}
+
+	coerced := make(map[string]any, len(definitions))
+
+	for _, definition := range definitions {
+
+		value, given := values[definition.Name]
+
+		// Unset variables are not given
+		if variable, ok := value.(Variable); ok {
+			if _, set := variables[string(variable)]; !set {
+				given = false
+			}
+		}
+
+		if !given {
+			if definition.Default != nil {
+				coerced[definition.Name] = definition.Default
+				continue
+			}
+			if _, nonNull := definition.Type.(*NonNull); nonNull {
+				return nil, fmt.Errorf("argument %s of type %s is required", definition.Name, definition.Type)
+			}
+			continue
+		}
+
+		value, err := coerceInput(value, definition.Type, variables)
+		if err != nil {
+			return nil, fmt.Errorf("argument %s: %w", definition.Name, err)
+		}
+		coerced[definition.Name] = value
+	}
+
+	return coerced, nil
+}
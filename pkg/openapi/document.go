@@ -0,0 +1,212 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Document represents the subset of an OpenAPI 3 document used to describe and validate requests
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info represents API metadata
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem represents the operations of a path, by lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation represents an API operation on a path
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Tags        []string            `json:"tags,omitempty"`
+	Deprecated  bool                `json:"deprecated,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter represents a path or query parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"` // "path" or "query"
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody represents an operation request body
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response represents an operation response
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType represents a body content of a media type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components represents reusable schemas
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema represents the subset of JSON schema validated
+type Schema struct {
+	Ref         string             `json:"$ref,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Nullable    bool               `json:"nullable,omitempty"`
+	Enum        []any              `json:"enum,omitempty"`
+	MinLength   *int               `json:"minLength,omitempty"`
+	MaxLength   *int               `json:"maxLength,omitempty"`
+	Minimum     *float64           `json:"minimum,omitempty"`
+	Maximum     *float64           `json:"maximum,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+}
+
+// Load parses an OpenAPI document from JSON data, checking its references exist.
+func Load(data []byte) (*Document, error) {
+
+	var document Document
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document: %w", err)
+	}
+
+	for path, item := range document.Paths {
+		for method, operation := range item {
+
+			schemas := make([]*Schema, 0)
+			for _, parameter := range operation.Parameters {
+				schemas = append(schemas, parameter.Schema)
+			}
+			if operation.RequestBody != nil {
+				for _, media := range operation.RequestBody.Content {
+					schemas = append(schemas, media.Schema)
+				}
+			}
+			for _, response := range operation.Responses {
+				for _, media := range response.Content {
+					schemas = append(schemas, media.Schema)
+				}
+			}
+
+			for _, schema := range schemas {
+				if err := document.checkRefs(schema); err != nil {
+					return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+				}
+			}
+		}
+	}
+
+	return &document, nil
+}
+
+// Find returns the operation and path parameters matching request method and path.
+// Literal segments take precedence over parameters, a last parameter matches the rest of the path.
+// Reports whether the path is known, even if method is not.
+func (d *Document) Find(method, path string) (*Operation, map[string]string, bool) {
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var best PathItem
+	var bestParams map[string]string
+	bestLiterals := -1
+
+	for template, item := range d.Paths {
+
+		params, literals, ok := match(strings.Split(strings.Trim(template, "/"), "/"), segments)
+		if ok && literals > bestLiterals {
+			best, bestParams, bestLiterals = item, params, literals
+		}
+	}
+
+	if best == nil {
+		return nil, nil, false
+	}
+
+	return best[strings.ToLower(method)], bestParams, true
+}
+
+// Schema returns the schema of a "#/components/schemas/..." reference.
+func (d *Document) Schema(ref string) (*Schema, bool) {
+	schema, exists := d.Components.Schemas[strings.TrimPrefix(ref, "#/components/schemas/")]
+	return schema, exists
+}
+
+// match returns template parameters values in segments and template literal segments count.
+func match(template, segments []string) (map[string]string, int, bool) {
+
+	params := make(map[string]string)
+	literals := 0
+
+	for i, part := range template {
+
+		if i >= len(segments) {
+			return nil, 0, false
+		}
+
+		if name, isParam := strings.CutPrefix(part, "{"); isParam {
+
+			name = strings.TrimSuffix(name, "}")
+			if i == len(template)-1 {
+				params[name] = strings.Join(segments[i:], "/")
+				return params, literals, params[name] != ""
+			}
+
+			params[name] = segments[i]
+			continue
+		}
+
+		if part != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+
+	return params, literals, len(template) == len(segments)
+}
+
+// checkRefs verifies every reference of schema exists in components.
+func (d *Document) checkRefs(schema *Schema) error {
+
+	if schema == nil {
+		return nil
+	}
+
+	if schema.Ref != "" {
+		if _, exists := d.Schema(schema.Ref); !exists {
+			return fmt.Errorf("unknown schema reference %s", schema.Ref)
+		}
+	}
+
+	if err := d.checkRefs(schema.Items); err != nil {
+		return err
+	}
+
+	for _, property := range schema.Properties {
+		if err := d.checkRefs(property); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+// ValidationError is returned when a request does not match the document
+type ValidationError struct {
+	Status  int // HTTP status to answer with
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validate checks request method, path and query parameters and JSON body against the document.
+// Request body is restored to be read again by handlers. Unknown paths are not validated.
+func (d *Document) Validate(request *http.Request) error {
+	return d.ValidatePath(request, request.URL.Path)
+}
+
+// ValidatePath validates request as if sent to path, Rx: a request to an alias of a documented path.
+func (d *Document) ValidatePath(request *http.Request, path string) error {
+
+	operation, params, known := d.Find(request.Method, path)
+	if !known {
+		return nil
+	}
+
+	if operation == nil {
+		return &ValidationError{Status: http.StatusMethodNotAllowed, Message: "Method not allowed"}
+	}
+
+	query := request.URL.Query()
+
+	for _, parameter := range operation.Parameters {
+
+		var value string
+		var present bool
+
+		switch parameter.In {
+		case "path":
+			value, present = params[parameter.Name]
+		case "query":
+			present = query.Has(parameter.Name)
+			value = query.Get(parameter.Name)
+		default:
+			continue
+		}
+
+		if !present || value == "" {
+			if parameter.Required {
+				return invalid("Missing %s parameter", parameter.Name)
+			}
+			continue
+		}
+
+		if err := d.validateParameter(parameter.Schema, value); err != nil {
+			return invalid("Invalid %s parameter: %s", parameter.Name, err)
+		}
+	}
+
+	if operation.RequestBody == nil {
+		return nil
+	}
+
+	media, exists := operation.RequestBody.Content["application/json"]
+	if !exists || media.Schema == nil {
+		return nil
+	}
+
+	data, err := io.ReadAll(request.Body)
+	if err != nil {
+		return invalid("Invalid request body")
+	}
+	request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(data))
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		if operation.RequestBody.Required {
+			return invalid("Missing request body")
+		}
+		return nil
+	}
+
+	var body any
+	if err := json.Unmarshal(data, &body); err != nil {
+		return invalid("Invalid JSON")
+	}
+
+	if err := d.validateValue(media.Schema, body, "body"); err != nil {
+		return invalid("Invalid request body: %s", err)
+	}
+
+	return nil
+}
+
+// invalid returns a bad request ValidationError.
+func invalid(format string, args ...any) error {
+	return &ValidationError{Status: http.StatusBadRequest, Message: fmt.Sprintf(format, args...)}
+}
+
+// validateParameter checks a path or query string value against schema, converted to its type.
+func (d *Document) validateParameter(schema *Schema, value string) error {
+
+	schema = d.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	switch schema.Type {
+	case "integer", "number":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || (schema.Type == "integer" && number != float64(int64(number))) {
+			return fmt.Errorf("expected %s", schema.Type)
+		}
+		return d.validateValue(schema, number, "value")
+	case "boolean":
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("expected boolean")
+		}
+		return d.validateValue(schema, boolean, "value")
+	default:
+		return d.validateValue(schema, value, "value")
+	}
+}
+
+// validateValue checks a decoded JSON value against schema, at path for messages.
+func (d *Document) validateValue(schema *Schema, value any, path string) error {
+
+	schema = d.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	if value == nil {
+		if schema.Nullable || schema.Type == "" {
+			return nil
+		}
+		return fmt.Errorf("%s must not be null", path)
+	}
+
+	if len(schema.Enum) > 0 && !slices.ContainsFunc(schema.Enum, func(allowed any) bool { return equal(allowed, value) }) {
+		return fmt.Errorf("%s must be one of %v", path, schema.Enum)
+	}
+
+	switch schema.Type {
+
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a string", path)
+		}
+
+		length := len([]rune(text))
+		if schema.MinLength != nil && length < *schema.MinLength {
+			return fmt.Errorf("%s must be at least %d characters", path, *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return fmt.Errorf("%s must be at most %d characters", path, *schema.MaxLength)
+		}
+
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok || (schema.Type == "integer" && number != float64(int64(number))) {
+			return fmt.Errorf("%s must be an %s", path, schema.Type)
+		}
+
+		if schema.Minimum != nil && number < *schema.Minimum {
+			return fmt.Errorf("%s must be at least %v", path, *schema.Minimum)
+		}
+		if schema.Maximum != nil && number > *schema.Maximum {
+			return fmt.Errorf("%s must be at most %v", path, *schema.Maximum)
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be an array", path)
+		}
+
+		for i, item := range items {
+			if err := d.validateValue(schema.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s must be an object", path)
+		}
+
+		for _, name := range schema.Required {
+			if _, exists := object[name]; !exists {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+
+		for name, property := range schema.Properties {
+			if field, exists := object[name]; exists {
+				if err := d.validateValue(property, field, path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolve returns the schema a reference points to, or schema itself.
+func (d *Document) resolve(schema *Schema) *Schema {
+
+	for schema != nil && schema.Ref != "" {
+
+		referenced, exists := d.Schema(schema.Ref)
+		if !exists {
+			return nil
+		}
+		schema = referenced
+	}
+
+	return schema
+}
+
+// equal compares JSON values, numbers whatever their Go type.
+func equal(a, b any) bool {
+
+	if x, ok := a.(float64); ok {
+		y, ok := b.(float64)
+		return ok && x == y
+	}
+
+	return a == b
+}
@@ -0,0 +1,74 @@
+package profanity
+
+import (
+	"strings"
+
+	pkgstrings "github.com/doruo/falloutdle/pkg/strings"
+)
+
+// Banned words, matched anywhere in normalized text.
+// Short words often found inside harmless ones are left out (Rx: "dick" in "Dickens").
+var banned = []string{
+	"asshole", "bastard", "bitch", "dildo", "faggot", "fuck", "hitler",
+	"kike", "nigga", "nigger", "penis", "pussy",
+	"retard", "shit", "slut", "twat", "vagina", "wanker", "whore",
+}
+
+// Banned words only matched as whole words, or their plural,
+// as they are found inside harmless ones (Rx: "rape" in "Grape", "cunt" in "Scunthorpe").
+var bannedWords = []string{"cunt", "nazi", "porn", "rape"}
+
+// Common letter substitutions, Rx: "5h1t" -> "shit"
+var leetspeak = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s", "!", "i",
+)
+
+// IsProfane determines if text contains a banned word,
+// ignoring case, accents, separators and common letter substitutions.
+func IsProfane(text string) bool {
+
+	normalized := pkgstrings.NormalizeLetters(leetspeak.Replace(strings.ToLower(text)))
+
+	for _, word := range banned {
+		if strings.Contains(normalized, word) {
+			return true
+		}
+	}
+
+	for _, word := range words(text) {
+		for _, bannedWord := range bannedWords {
+			if word == bannedWord || word == bannedWord+"s" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// words splits text in normalized words, single letters in a row joined back together.
+// Rx: "R.A.P.E in Grapes" -> ["rape", "in", "grapes"]
+func words(text string) []string {
+
+	var found []string
+	letters := ""
+
+	for _, word := range strings.Fields(pkgstrings.FoldWords(leetspeak.Replace(strings.ToLower(text)))) {
+		if len([]rune(word)) == 1 {
+			letters += word
+			continue
+		}
+
+		if letters != "" {
+			found = append(found, letters)
+			letters = ""
+		}
+		found = append(found, word)
+	}
+
+	if letters != "" {
+		found = append(found, letters)
+	}
+
+	return found
+}
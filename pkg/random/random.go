@@ -11,3 +11,16 @@ func NewRandom() *rand.Rand {
 	source := rand.NewSource(seed)
 	return rand.New(source)
 }
+
+// NewSeededRandom returns a new random using given seed,
+// same seed always gives same random sequence.
+func NewSeededRandom(seed int64) *rand.Rand {
+	source := rand.NewSource(seed)
+	return rand.New(source)
+}
+
+// DailySeed returns a seed based on given date day, same for the whole day.
+func DailySeed(date time.Time) int64 {
+	year, month, day := date.UTC().Date()
+	return int64(year*10000 + int(month)*100 + day)
+}
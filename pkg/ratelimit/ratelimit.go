@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows a limited number of hits per key in a fixed time window
+type Limiter struct {
+	mutex   sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[string]*window
+	pruned  time.Time
+}
+
+// window represents hits of a key since its start
+type window struct {
+	start time.Time
+	hits  int
+}
+
+// NewLimiter creates a limiter allowing limit hits per key in each window of duration
+func NewLimiter(limit int, duration time.Duration) *Limiter {
+	return &Limiter{
+		limit:   limit,
+		window:  duration,
+		windows: make(map[string]*window),
+		pruned:  time.Now(),
+	}
+}
+
+// Allow records a hit of key, and determines if it is within the limit.
+func (l *Limiter) Allow(key string) bool {
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.prune(now)
+
+	w, exists := l.windows[key]
+	if !exists || now.Sub(w.start) >= l.window {
+		w = &window{start: now}
+		l.windows[key] = w
+	}
+
+	w.hits++
+
+	return w.hits <= l.limit
+}
+
+// prune removes ended windows, at most once per window duration.
+func (l *Limiter) prune(now time.Time) {
+
+	if now.Sub(l.pruned) < l.window {
+		return
+	}
+
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.window {
+			delete(l.windows, key)
+		}
+	}
+
+	l.pruned = now
+}
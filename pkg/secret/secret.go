@@ -0,0 +1,62 @@
+package secret
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+)
+
+// Keys already derived, by environment variable name
+var keys sync.Map
+
+// Key returns a 32 bytes key derived from environment variable value.
+// Falls back to a random key, only valid until restart, if variable is not set.
+func Key(envName string) []byte {
+
+	if key, exists := keys.Load(envName); exists {
+		return key.([]byte)
+	}
+
+	var key []byte
+
+	if value := os.Getenv(envName); value != "" {
+		sum := sha256.Sum256([]byte(value))
+		key = sum[:]
+	} else {
+		log.Printf("WARNING: %s is not set, using a random key until restart", envName)
+
+		key = make([]byte, sha256.Size)
+		rand.Read(key)
+	}
+
+	actual, _ := keys.LoadOrStore(envName, key)
+	return actual.([]byte)
+}
+
+// RandomToken returns a random token of n bytes, hex encoded.
+func RandomToken(n int) string {
+	bytes := make([]byte, n)
+	rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
+
+// Code alphabet, without easily confused characters (0/O, 1/I/L)
+const codeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// RandomCode returns a random human readable code of n characters.
+func RandomCode(n int) string {
+
+	code := make([]byte, n)
+	limit := big.NewInt(int64(len(codeAlphabet)))
+
+	for i := range code {
+		index, _ := rand.Int(rand.Reader, limit)
+		code[i] = codeAlphabet[index.Int64()]
+	}
+
+	return string(code)
+}
@@ -1,6 +1,11 @@
 package strings
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
 
 // NormalizeString converts all string spaces into "_" and retuns the result.
 // Rx: "Name Surname" -> "Name_Surname"
@@ -14,3 +19,75 @@ func NormalizeString(str string) string {
 func UnnormalizeString(str string) string {
 	return strings.ReplaceAll(str, "_", " ")
 }
+
+// NormalizeLetters keeps only string letters and digits, lower case and without accents.
+// Rx: "Mr. Élise-Doe" -> "mrelisedoe"
+func NormalizeLetters(str string) string {
+
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(str) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return builder.String()
+}
+
+// FoldWords keeps only string words of letters and digits, lower case and without accents,
+// separated by a single space.
+// Rx: "Mr. Élise-Doe" -> "mr elise doe"
+func FoldWords(str string) string {
+
+	var builder strings.Builder
+	separated := true
+
+	for _, r := range norm.NFD.String(str) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(unicode.ToLower(r))
+			separated = false
+		case unicode.Is(unicode.Mn, r) || r == '\'' || r == '’':
+			// Accents and apostrophes are part of the word
+		case !separated:
+			builder.WriteRune(' ')
+			separated = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), " ")
+}
+
+// Levenshtein returns the edit distance between two strings: the least count
+// of inserted, deleted or substituted characters to change one into the other.
+// Rx: "caesar", "ceasar" -> 2
+func Levenshtein(a, b string) int {
+
+	source, target := []rune(a), []rune(b)
+
+	// Distances from source prefix to previous and current target prefixes
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := range source {
+
+		current[0] = i + 1
+		for j := range target {
+
+			cost := 1
+			if source[i] == target[j] {
+				cost = 0
+			}
+
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
@@ -6,3 +6,8 @@ import "time"
 func Today() time.Time {
 	return time.Now().UTC().Truncate(24 * time.Hour)
 }
+
+// Now returns current time in UTC.
+func Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/doruo/falloutdle/internal/achievement"
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+func TestAchievementEvaluate(t *testing.T) {
+
+	harold := newTestCharacter(1, "Harold")
+	harold.Race = "Ghoul"
+	harold.Games = []string{"FO1", "FO2", "FO3"}
+
+	marcus := newTestCharacter(2, "Marcus")
+	marcus.Race = "Super mutant"
+	marcus.Games = []string{"FO2", "FNV"}
+
+	facts := &achievement.Facts{
+		Results: []stats.Result{
+			{Mode: "classic", Won: true, Guesses: 1, CharacterID: 1},
+			{Mode: "classic", Won: true, Guesses: 4, CharacterID: 2},
+			{Mode: "spelling", Won: false, Guesses: 6, CharacterID: 2},
+			{Mode: "classic", Won: true, Guesses: 1, CharacterID: 2, Practice: true},
+		},
+		Stats: []stats.StatView{
+			{Stat: stats.Stat{Mode: "classic", MaxStreak: 8}},
+		},
+		Characters: map[uint]*character.Character{1: harold, 2: marcus},
+	}
+
+	cases := map[achievement.Definition][2]int{
+		{Criteria: achievement.Criteria{Metric: achievement.WinsMetric}, Target: 100}:                      {2, 100},
+		{Criteria: achievement.Criteria{Metric: achievement.WinsMetric, MaxGuesses: 1}, Target: 1}:         {1, 1},
+		{Criteria: achievement.Criteria{Metric: achievement.WinsMetric, Race: "super mutant"}, Target: 10}: {1, 10},
+		{Criteria: achievement.Criteria{Metric: achievement.StreakMetric}, Target: 7}:                      {7, 7},
+		{Criteria: achievement.Criteria{Metric: achievement.CharactersMetric, Game: "FO2"}}:                {2, 2},
+		{Criteria: achievement.Criteria{Metric: achievement.CharactersMetric, Game: "FO4"}}:                {0, 0},
+	}
+
+	for definition, expected := range cases {
+
+		current, target := definition.Evaluate(facts)
+		if current != expected[0] || target != expected[1] {
+			t.Errorf("%+v: expected %d/%d, got %d/%d", definition.Criteria, expected[0], expected[1], current, target)
+		}
+	}
+
+	// No matching character to solve is never unlocked
+	empty := achievement.Definition{Criteria: achievement.Criteria{Metric: achievement.CharactersMetric, Game: "FO4"}}
+	if empty.IsUnlocked(facts) {
+		t.Errorf("Expected achievement without target not to be unlocked")
+	}
+}
+
+func TestAchievementPlayedAnswer(t *testing.T) {
+
+	// Every daily answer is marked as played once picked
+	answer := newTestCharacter(1, "Marcus")
+	answer.Race = "Super mutant"
+	answer.Games = []string{"FO2", "FNV"}
+	answer.UpdateAsPlayed()
+
+	results := []stats.Result{{Mode: "classic", Won: true, Guesses: 3, CharacterID: 1}}
+	facts := achievement.NewFacts(results, nil, []character.Character{*answer})
+
+	race := achievement.Definition{Criteria: achievement.Criteria{Metric: achievement.WinsMetric, Race: "super mutant"}, Target: 10}
+	if current, _ := race.Evaluate(facts); current != 1 {
+		t.Errorf("Expected played answer win counted, got %d", current)
+	}
+
+	every := achievement.Definition{Criteria: achievement.Criteria{Metric: achievement.CharactersMetric, Game: "FNV"}}
+	if current, target := every.Evaluate(facts); current != 1 || target != 1 {
+		t.Errorf("Expected played answer among every FNV characters, got %d/%d", current, target)
+	}
+}
+
+func TestAchievementIncompleteCharacter(t *testing.T) {
+
+	answer := newTestCharacter(1, "Marcus")
+	answer.Race = "Super mutant"
+	answer.Games = []string{"FNV"}
+
+	// No race, never picked as a daily answer
+	incomplete := newTestCharacter(2, "Fisto")
+	incomplete.Games = []string{"FNV"}
+
+	results := []stats.Result{{Mode: "classic", Won: true, Guesses: 3, CharacterID: 1}}
+	facts := achievement.NewFacts(results, nil, []character.Character{*answer, *incomplete})
+
+	every := achievement.Definition{Criteria: achievement.Criteria{Metric: achievement.CharactersMetric, Game: "FNV"}}
+	if current, target := every.Evaluate(facts); current != 1 || target != 1 {
+		t.Errorf("Expected incomplete character left out of every FNV characters, got %d/%d", current, target)
+	}
+
+	if !every.IsUnlocked(facts) {
+		t.Error("Expected every FNV characters unlocked")
+	}
+}
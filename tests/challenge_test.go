@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/doruo/falloutdle/internal/challenge"
+)
+
+func TestChallengeToken(t *testing.T) {
+
+	tokener, err := challenge.NewTokener(bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	token := tokener.Seal(42)
+
+	id, err := tokener.Open(token)
+	if err != nil || id != 42 {
+		t.Fatalf("Expected challenge 42, got %d (%v)", id, err)
+	}
+
+	// Any change in token must be detected
+	tampered := []byte(token)
+	tampered[len(tampered)/2] ^= 1
+
+	if _, err := tokener.Open(string(tampered)); err != challenge.ErrInvalidToken {
+		t.Errorf("Expected %v, got %v", challenge.ErrInvalidToken, err)
+	}
+}
@@ -1,8 +1,12 @@
 package tests
 
 import (
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/doruo/falloutdle/external/wiki"
 	"github.com/doruo/falloutdle/internal/character"
 )
 
@@ -13,3 +17,193 @@ func TestNewCharacter(t *testing.T) {
 		t.Errorf("expected Dogmeat, got %s", char.Name)
 	}
 }
+
+func TestSearchIndex(t *testing.T) {
+
+	house := newTestCharacter(1, "Robert Edwin House")
+	house.Titles = []string{"Mr. House"}
+	arcade := newTestCharacter(2, "Arcade Gannon")
+	arcadeRobot := newTestCharacter(3, "Arcade Gannon Sr.")
+
+	loads := 0
+	index := character.NewIndex(func() ([]character.Character, error) {
+		loads++
+		return []character.Character{*house, *arcade, *arcadeRobot}, nil
+	})
+
+	results, err := index.Search("arcade", 10)
+	if err != nil || len(results) != 2 || results[0].ID != 2 {
+		t.Fatalf("Expected shorter Arcade Gannon first of 2 results, got %v (%v)", results, err)
+	}
+
+	// Word prefix of a title
+	results, _ = index.Search("hous", 1)
+	if len(results) != 1 || results[0].ID != 1 {
+		t.Errorf("Expected Robert Edwin House, got %v", results)
+	}
+
+	// Typo, accents and case
+	results, _ = index.Search("Árcade Ganon", 1)
+	if len(results) != 1 || results[0].ID != 2 {
+		t.Errorf("Expected Arcade Gannon, got %v", results)
+	}
+
+	index.Invalidate()
+	index.Search("arcade", 10)
+
+	if loads != 2 {
+		t.Errorf("Expected index rebuilt once after invalidation, got %d loads", loads)
+	}
+}
+
+func TestResolveName(t *testing.T) {
+
+	house := newTestCharacter(1, "Robert Edwin House")
+	house.Titles = []string{"Mr. House"}
+	caesar := newTestCharacter(2, "Caesar")
+	dogmeat := newTestCharacter(3, "Dogmeat")
+	dogmeat4 := newTestCharacter(4, "Dogmeat")
+	dogmeat4.WikiTitle = "Dogmeat (Fallout 4)"
+
+	characters := []character.Character{*house, *caesar, *dogmeat, *dogmeat4}
+
+	for name, id := range map[string]uint{"Caesar": 2, "caesar": 2, "Mr House": 1, "Ceasar": 2, "robert edwin hose": 1} {
+		char, err := character.ResolveName(name, characters)
+		if err != nil || char.ID != id {
+			t.Errorf("Expected %q to resolve to %d, got %v (%v)", name, id, char, err)
+		}
+	}
+
+	// Same folded name is ambiguous, every candidate is then picked from its wiki title
+	var ambiguous *character.AmbiguousNameError
+	if _, err := character.ResolveName("dogmeat", characters); !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != 2 {
+		t.Fatalf("Expected %q to have 2 candidates, got %v", "dogmeat", err)
+	}
+
+	for _, candidate := range ambiguous.Candidates {
+		char, err := character.ResolveName(candidate.WikiTitle, characters)
+		if err != nil || char.ID != candidate.ID {
+			t.Errorf("Expected %q to resolve to %d, got %v (%v)", candidate.WikiTitle, candidate.ID, char, err)
+		}
+	}
+
+	if char, err := character.ResolveName("Dogmeat_(Fallout_4)", characters); err != nil || char.ID != 4 {
+		t.Errorf("Expected wiki title with underscores to resolve to 4, got %v (%v)", char, err)
+	}
+
+	if _, err := character.ResolveName("Benny", characters); err != character.ErrCharacterNotFound {
+		t.Errorf("Expected %v, got %v", character.ErrCharacterNotFound, err)
+	}
+}
+
+func TestCharacterAliases(t *testing.T) {
+
+	content := `{{Infobox character
+|name = Robert Edwin House
+|titles = "Mr. House"
+}}`
+
+	char, err := wiki.NewWikiClient().ParseCharacterFromContent("Robert House", content)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	char.ID = 1
+	char.AddAlias("Robert House", character.RedirectAlias)
+	char.AddAlias("Mr House", character.RedirectAlias)        // Same as title
+	char.AddAlias("Robert Edwin House", character.TitleAlias) // Same as name
+
+	if len(char.Aliases) != 2 {
+		t.Fatalf("Expected 2 aliases, got %v", char.Aliases)
+	}
+
+	master := newTestCharacter(2, "Richard Grey")
+	master.AddAlias("The Master", character.RedirectAlias)
+
+	characters := []character.Character{*char, *master}
+
+	if found, err := character.ResolveName("the master", characters); err != nil || found.ID != 2 {
+		t.Errorf("Expected The Master to resolve to Richard Grey, got %v (%v)", found, err)
+	}
+
+	index := character.NewIndex(func() ([]character.Character, error) {
+		return characters, nil
+	})
+
+	results, _ := index.Search("master", 1)
+	if len(results) != 1 || results[0].ID != 2 || results[0].Match != "The Master" {
+		t.Errorf("Expected Richard Grey matched as The Master, got %v", results)
+	}
+}
+
+func TestListItem(t *testing.T) {
+
+	char := newTestCharacter(1, "Arcade Gannon")
+	char.UpdateAsPlayed()
+
+	data, err := json.Marshal(character.NewListItem(char))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Played date would give away daily answers
+	if strings.Contains(string(data), "played") {
+		t.Errorf("Expected no played date in %s", data)
+	}
+
+	for sort, valid := range map[character.Sort]bool{"name": true, "-id": true, "played_at": false, "": false} {
+		if sort.IsValid() != valid {
+			t.Errorf("Expected sort %q valid %v", sort, valid)
+		}
+	}
+}
+
+func TestListFilter(t *testing.T) {
+
+	char := newTestCharacter(1, "Arcade Gannon")
+	char.Games = []string{"FNV"}
+	char.Race = "Human"
+	char.Affiliation = []string{"Followers of the Apocalypse"}
+
+	tests := map[character.ListFilter]bool{
+		{}:                           true,
+		{Game: "FNV", Race: "human"}: true,
+		{Affiliation: "followers of the apocalypse"}: true,
+		{Game: "FO3"}:                    false,
+		{Game: "fnv"}:                    false,
+		{Affiliation: "NCR"}:             false,
+		{Race: "Human", Status: "Alive"}: false,
+	}
+
+	for filter, expected := range tests {
+		if matches := filter.Matches(char); matches != expected {
+			t.Errorf("Expected filter %+v matching %v, got %v", filter, expected, matches)
+		}
+	}
+}
+
+func TestCharacterView(t *testing.T) {
+
+	char := newTestCharacter(1, "Arcade Gannon")
+	char.Games = []string{"FNV"}
+	char.MainGame = "FNV"
+	char.ImageURL = "Arcade Gannon.png"
+	char.UpdateAsPlayed()
+
+	view := char.View()
+
+	if view.MainGame == nil || view.MainGame.Name != "Fallout: New Vegas" {
+		t.Errorf("Expected Fallout: New Vegas main game, got %v", view.MainGame)
+	}
+
+	if view.ImageURL != "https://fallout.fandom.com/wiki/Special:FilePath/Arcade_Gannon.png" {
+		t.Errorf("Unexpected image URL %s", view.ImageURL)
+	}
+
+	// Raw characters neither expose played date
+	for _, value := range []any{view, char} {
+		if data, _ := json.Marshal(value); strings.Contains(string(data), "played") {
+			t.Errorf("Expected no played date in %s", data)
+		}
+	}
+}
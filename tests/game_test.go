@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/character"
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/pkg/random"
+)
+
+func newTestCharacter(id uint, name string) *character.Character {
+	char := character.NewCharacter(name, name)
+	char.ID = id
+	return char
+}
+
+func TestCompareCharacters(t *testing.T) {
+
+	answer := newTestCharacter(1, "Arcade Gannon")
+	answer.Race = "Human"
+	answer.MainGame = "FNV"
+	answer.Games = []string{"FNV"}
+	answer.Affiliation = []string{"Followers of the Apocalypse", "Enclave"}
+	answer.Actors = []string{"Zachary Levi"}
+
+	guess := newTestCharacter(2, "Roger Maxson")
+	guess.Race = "human"
+	guess.MainGame = "FO1"
+	guess.Games = []string{"FO1", "FO3"}
+	guess.Affiliation = []string{"Enclave"}
+	guess.Actors = []string{"Zachary Levi"}
+
+	result := game.CompareCharacters(guess, answer)
+
+	if result.Correct {
+		t.Fatalf("Expected incorrect guess")
+	}
+
+	expected := map[string]game.Verdict{
+		game.RaceAttribute:        game.Correct,
+		game.MainGameAttribute:    game.Incorrect,
+		game.GamesAttribute:       game.Incorrect,
+		game.AffiliationAttribute: game.Partial,
+		game.ActorsAttribute:      game.Correct,
+	}
+
+	for _, attribute := range result.Attributes {
+		if verdict, ok := expected[attribute.Attribute]; ok && verdict != attribute.Verdict {
+			t.Errorf("Expected %s verdict %s, got %s", attribute.Attribute, verdict, attribute.Verdict)
+		}
+		if attribute.Attribute == game.MainGameAttribute && attribute.Hint != game.Later {
+			t.Errorf("Expected main game hint %s, got %s", game.Later, attribute.Hint)
+		}
+	}
+
+	if !game.CompareCharacters(answer, answer).Correct {
+		t.Errorf("Expected correct guess")
+	}
+}
+
+func TestGenerateConnectionsPuzzle(t *testing.T) {
+
+	var characters []character.Character
+	id := uint(1)
+
+	// Five characters for each attribute, sharing nothing else
+	for i := 0; i < 5; i++ {
+		add := func(setup func(c *character.Character)) {
+			char := newTestCharacter(id, fmt.Sprintf("Character %d", id))
+			char.Race = fmt.Sprintf("Race %d", id)
+			setup(char)
+			characters = append(characters, *char)
+			id++
+		}
+		add(func(c *character.Character) { c.Affiliation = []string{"Enclave"} })
+		add(func(c *character.Character) { c.Games = []string{"FO2"} })
+		add(func(c *character.Character) { c.Race = "Ghoul" })
+		add(func(c *character.Character) { c.Titles = []string{"The Overseer"} })
+	}
+
+	puzzle, err := game.GenerateConnectionsPuzzle(characters, random.NewSeededRandom(1))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(puzzle.Cards) != game.ConnectionsGroups*game.ConnectionsGroupSize {
+		t.Fatalf("Expected %d cards, got %d", game.ConnectionsGroups*game.ConnectionsGroupSize, len(puzzle.Cards))
+	}
+
+	progress := &game.ConnectionsProgress{}
+
+	// Wrong group: one character of each group
+	var wrong []uint
+	for _, group := range puzzle.Groups {
+		wrong = append(wrong, group.CharacterIDs[0])
+	}
+	verdict, err := puzzle.Verify(progress, wrong)
+	if err != nil || verdict.Correct || verdict.Mistakes != 1 {
+		t.Fatalf("Expected one mistake, got %+v (%v)", verdict, err)
+	}
+
+	for _, group := range puzzle.Groups {
+		verdict, err = puzzle.Verify(progress, group.CharacterIDs)
+		if err != nil || !verdict.Correct {
+			t.Fatalf("Expected correct group %s, got %+v (%v)", group.Value, verdict, err)
+		}
+	}
+
+	if !verdict.Solved || !verdict.Finished {
+		t.Errorf("Expected solved puzzle, got %+v", verdict)
+	}
+
+	if _, err := puzzle.Verify(progress, wrong); err != game.ErrPuzzleFinished {
+		t.Errorf("Expected %v, got %v", game.ErrPuzzleFinished, err)
+	}
+}
+
+func TestPickDailyCharacter(t *testing.T) {
+
+	date := time.Date(2025, time.August, 14, 0, 0, 0, 0, time.UTC)
+
+	var candidates []character.Character
+	for id := uint(1); id <= 20; id++ {
+		char := newTestCharacter(id, fmt.Sprintf("Character Number %d", id))
+		char.Actors = []string{fmt.Sprintf("Actor %d", id)}
+		candidates = append(candidates, *char)
+	}
+
+	answer, err := game.PickDailyCharacter(candidates, game.ClassicMode, date)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Picked answer is marked as played, then the server restarts and candidates come in another order
+	restarted := slices.Clone(candidates)
+	slices.Reverse(restarted)
+	for i := range restarted {
+		if restarted[i].ID == answer.ID {
+			playedAt := date.Add(3 * time.Hour)
+			restarted[i].PlayedAt = &playedAt
+		}
+	}
+
+	again, err := game.PickDailyCharacter(restarted, game.ClassicMode, date)
+	if err != nil || again.ID != answer.ID {
+		t.Errorf("Expected same answer %d after restart, got %v (%v)", answer.ID, again, err)
+	}
+
+	if actor, err := game.PickDailyCharacter(candidates, game.ActorMode, date); err != nil || actor.ID == answer.ID {
+		t.Errorf("Expected actor answer different from classic answer %d, got %v (%v)", answer.ID, actor, err)
+	}
+
+	// Played on puzzle date is still a candidate, played before is not
+	played := newTestCharacter(21, "Played")
+	for playedAt, before := range map[time.Time]bool{date.Add(-time.Hour): true, date.Add(time.Hour): false} {
+		played.PlayedAt = &playedAt
+		if played.IsPlayedBefore(date) != before {
+			t.Errorf("Expected played at %v before %v: %t", playedAt, date, before)
+		}
+	}
+}
+
+func TestSpellName(t *testing.T) {
+
+	// Shorter guess, duplicated letters and accents
+	results := game.SpellName("Élla", "Lane")
+	expected := []game.LetterFeedback{game.Yellow, game.Yellow, game.Grey, game.Yellow}
+
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d letters, got %d", len(expected), len(results))
+	}
+
+	for i, feedback := range expected {
+		if results[i].Feedback != feedback {
+			t.Errorf("Expected letter %s %s, got %s", results[i].Letter, feedback, results[i].Feedback)
+		}
+	}
+
+	for _, result := range game.SpellName("Roger Maxson", "roger maxson") {
+		if result.Feedback != game.Green {
+			t.Errorf("Expected letter %s green, got %s", result.Letter, result.Feedback)
+		}
+	}
+}
+
+func TestHigherLowerRound(t *testing.T) {
+
+	veteran := newTestCharacter(1, "Veteran")
+	veteran.Games = []string{"FO1", "FO2", "FO3"}
+	veteran.Titles = []string{"Elder", "Paladin"}
+	veteran.Affiliation = []string{"Brotherhood of Steel"}
+
+	rookie := newTestCharacter(2, "Rookie")
+	rookie.Games = []string{"FO4"}
+
+	characters := []character.Character{*veteran, *rookie}
+
+	round, err := game.NewHigherLowerRound(characters, veteran, random.NewSeededRandom(1))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Veteran has more of every facet except a later release year
+	expected := game.ChoiceA
+	if round.Facet == game.ReleaseYearFacet {
+		expected = game.ChoiceB
+	}
+
+	if correct, err := round.Answer(expected); err != nil || !correct {
+		t.Errorf("Expected choice %s correct for facet %s, got %v (%v)", expected, round.Facet, correct, err)
+	}
+
+	if _, err := round.Answer("c"); err == nil {
+		t.Errorf("Expected invalid choice error")
+	}
+}
+
+func TestHigherLowerRunStale(t *testing.T) {
+
+	start := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	run := game.NewHigherLowerRun(&game.HigherLowerRound{}, start)
+
+	if run.IsStale(start.Add(30 * time.Minute)) {
+		t.Error("Expected run played in the last hour kept")
+	}
+
+	if !run.IsStale(start.Add(2 * time.Hour)) {
+		t.Error("Expected run abandoned for 2 hours stale")
+	}
+
+	// Still played, but started on previous day
+	run.PlayedAt = start.Add(4 * time.Hour)
+	if !run.IsStale(start.Add(4*time.Hour + time.Minute)) {
+		t.Error("Expected run started on previous day stale")
+	}
+
+	run = game.NewHigherLowerRun(&game.HigherLowerRound{}, start)
+	run.Over = true
+	if !run.IsStale(start) {
+		t.Error("Expected over run stale")
+	}
+}
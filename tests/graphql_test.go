@@ -0,0 +1,256 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/doruo/falloutdle/pkg/graphql"
+)
+
+// newTestGraphQLSchema creates a schema of characters and a puzzle, its answer only resolved once finished.
+func newTestGraphQLSchema() *graphql.Schema {
+
+	gameEnum := &graphql.Enum{Name: "GameCode", Values: []string{"FO3", "FNV"}}
+
+	gameType := &graphql.Object{
+		Name: "Game",
+		Fields: []*graphql.Field{
+			{Name: "code", Type: &graphql.NonNull{Of: gameEnum}},
+			{Name: "name", Type: &graphql.NonNull{Of: graphql.String}},
+		},
+	}
+
+	characterType := &graphql.Object{Name: "Character"}
+	characterType.Fields = []*graphql.Field{
+		{Name: "id", Type: &graphql.NonNull{Of: graphql.ID}},
+		{Name: "name", Type: &graphql.NonNull{Of: graphql.String}},
+		{Name: "games", Type: &graphql.List{Of: gameType}},
+		{Name: "friend", Type: characterType},
+		{
+			Name: "broken",
+			Type: &graphql.NonNull{Of: graphql.String},
+			Resolve: func(params graphql.ResolveParams) (any, error) {
+				return nil, errors.New("broken field")
+			},
+		},
+	}
+
+	characters := []map[string]any{
+		{"id": uint(1), "name": "Cass", "games": []map[string]any{{"code": "FNV", "name": "Fallout: New Vegas"}}},
+		{"id": uint(2), "name": "Three Dog", "games": []map[string]any{{"code": "FO3", "name": "Fallout 3"}}},
+	}
+	characters[0]["friend"] = characters[1]
+	characters[1]["friend"] = characters[0]
+
+	filterType := &graphql.InputObject{
+		Name:   "CharacterFilter",
+		Fields: []*graphql.Argument{{Name: "game", Type: gameEnum}},
+	}
+
+	puzzleType := &graphql.Object{
+		Name: "Puzzle",
+		Fields: []*graphql.Field{
+			{Name: "finished", Type: &graphql.NonNull{Of: graphql.Boolean}},
+			{
+				Name: "answer",
+				Type: characterType,
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+					if puzzle := params.Source.(map[string]any); puzzle["finished"] == true {
+						return characters[0], nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	}
+
+	query := &graphql.Object{
+		Name: "Query",
+		Fields: []*graphql.Field{
+			{
+				Name: "characters",
+				Type: &graphql.NonNull{Of: &graphql.List{Of: &graphql.NonNull{Of: characterType}}},
+				Args: []*graphql.Argument{
+					{Name: "filter", Type: filterType},
+					{Name: "first", Type: &graphql.NonNull{Of: graphql.Int}, Default: 10},
+				},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+
+					game := ""
+					if filter, ok := params.Args["filter"].(map[string]any); ok {
+						game, _ = filter["game"].(string)
+					}
+
+					var found []map[string]any
+					for _, char := range characters {
+						if len(found) < params.Args["first"].(int) && (game == "" || char["games"].([]map[string]any)[0]["code"] == game) {
+							found = append(found, char)
+						}
+					}
+
+					return found, nil
+				},
+			},
+			{
+				Name: "character",
+				Type: characterType,
+				Args: []*graphql.Argument{{Name: "id", Type: &graphql.NonNull{Of: graphql.ID}}},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+					for _, char := range characters {
+						if id, _ := graphql.ID.Serialize(char["id"]); params.Args["id"] == id {
+							return char, nil
+						}
+					}
+					return nil, nil
+				},
+			},
+			{
+				Name: "puzzle",
+				Type: &graphql.NonNull{Of: puzzleType},
+				Args: []*graphql.Argument{{Name: "finished", Type: &graphql.NonNull{Of: graphql.Boolean}}},
+				Resolve: func(params graphql.ResolveParams) (any, error) {
+					return map[string]any{"finished": params.Args["finished"]}, nil
+				},
+			},
+		},
+	}
+
+	return graphql.NewSchema(query)
+}
+
+func TestGraphQLExecute(t *testing.T) {
+
+	schema := newTestGraphQLSchema()
+
+	tests := []struct {
+		name      string
+		request   graphql.Request
+		expected  string
+		errorsLen int
+	}{
+		{
+			name:     "nested fields",
+			request:  graphql.Request{Query: `{ characters { id name games { code name } } }`},
+			expected: `{"characters":[{"id":"1","name":"Cass","games":[{"code":"FNV","name":"Fallout: New Vegas"}]},{"id":"2","name":"Three Dog","games":[{"code":"FO3","name":"Fallout 3"}]}]}`,
+		},
+		{
+			name:     "filter and default argument",
+			request:  graphql.Request{Query: `{ characters(filter: {game: FO3}) { name } }`},
+			expected: `{"characters":[{"name":"Three Dog"}]}`,
+		},
+		{
+			name: "variables",
+			request: graphql.Request{
+				Query:     `query Find($id: ID!, $first: Int = 1) { character(id: $id) { name } characters(first: $first) { name } }`,
+				Variables: map[string]any{"id": float64(2)},
+			},
+			expected: `{"character":{"name":"Three Dog"},"characters":[{"name":"Cass"}]}`,
+		},
+		{
+			name:     "aliases, fragments and typename",
+			request:  graphql.Request{Query: `{ cass: character(id: "1") { ...Names friend { ... on Character { name } } } } fragment Names on Character { __typename name }`},
+			expected: `{"cass":{"__typename":"Character","name":"Cass","friend":{"name":"Three Dog"}}}`,
+		},
+		{
+			name: "skip and include",
+			request: graphql.Request{
+				Query:     `query($withID: Boolean!) { character(id: 1) { id @include(if: $withID) name @skip(if: true) } }`,
+				Variables: map[string]any{"withID": false},
+			},
+			expected: `{"character":{}}`,
+		},
+		{
+			name:     "operation name",
+			request:  graphql.Request{Query: `query A { character(id: 1) { name } } query B { character(id: 2) { name } }`, OperationName: "B"},
+			expected: `{"character":{"name":"Three Dog"}}`,
+		},
+		{
+			name:      "null propagation",
+			request:   graphql.Request{Query: `{ character(id: 1) { name broken } }`},
+			expected:  `{"character":null}`,
+			errorsLen: 1,
+		},
+		{
+			name:     "unfinished puzzle hides answer",
+			request:  graphql.Request{Query: `{ puzzle(finished: false) { finished answer { name } } }`},
+			expected: `{"puzzle":{"finished":false,"answer":null}}`,
+		},
+		{
+			name:     "finished puzzle reveals answer",
+			request:  graphql.Request{Query: `{ puzzle(finished: true) { finished answer { name } } }`},
+			expected: `{"puzzle":{"finished":true,"answer":{"name":"Cass"}}}`,
+		},
+	}
+
+	for _, test := range tests {
+
+		result := schema.Execute(context.Background(), test.request)
+
+		if string(result.Data) != test.expected {
+			t.Errorf("%s: expected data %s, got %s (%v)", test.name, test.expected, result.Data, result.Errors)
+		}
+
+		if len(result.Errors) != test.errorsLen {
+			t.Errorf("%s: expected %d errors, got %v", test.name, test.errorsLen, result.Errors)
+		}
+	}
+}
+
+func TestGraphQLRequestErrors(t *testing.T) {
+
+	schema := newTestGraphQLSchema()
+
+	deep := "{ character(id: 1) {" + strings.Repeat(" friend {", 10) + " name" + strings.Repeat(" }", 10) + " } }"
+
+	tests := map[string]graphql.Request{
+		"syntax error":        {Query: `{ characters { name }`},
+		"unknown field":       {Query: `{ characters { age } }`},
+		"unknown argument":    {Query: `{ characters(limit: 2) { name } }`},
+		"missing argument":    {Query: `{ character { name } }`},
+		"missing selection":   {Query: `{ character(id: 1) }`},
+		"leaf selection":      {Query: `{ character(id: 1) { name { first } } }`},
+		"unknown fragment":    {Query: `{ character(id: 1) { ...Missing } }`},
+		"fragment cycle":      {Query: `{ character(id: 1) { ...A } } fragment A on Character { friend { ...A } }`},
+		"unknown directive":   {Query: `{ character(id: 1) { name @deprecated } }`},
+		"too deep":            {Query: deep},
+		"mutation":            {Query: `mutation { characters { name } }`},
+		"ambiguous operation": {Query: `query A { characters { name } } query B { characters { name } }`},
+		"invalid enum":        {Query: `{ characters(filter: {game: FO4}) { name } }`},
+		"missing variable":    {Query: `query($id: ID!) { character(id: $id) { name } }`},
+		"invalid variable":    {Query: `query($first: Int) { characters(first: $first) { name } }`, Variables: map[string]any{"first": "two"}},
+	}
+
+	for name, request := range tests {
+
+		result := schema.Execute(context.Background(), request)
+
+		if result.Data != nil {
+			t.Errorf("%s: expected no data, got %s", name, result.Data)
+		}
+
+		if len(result.Errors) == 0 {
+			t.Errorf("%s: expected errors", name)
+		}
+	}
+}
+
+func TestGraphQLSchemaString(t *testing.T) {
+
+	sdl := newTestGraphQLSchema().String()
+
+	expected := []string{
+		"schema {\n  query: Query\n}",
+		"enum GameCode {\n  FO3\n  FNV\n}",
+		"input CharacterFilter {\n  game: GameCode\n}",
+		"  characters(filter: CharacterFilter, first: Int! = 10): [Character!]!",
+		"  friend: Character",
+	}
+
+	for _, part := range expected {
+		if !strings.Contains(sdl, part) {
+			t.Errorf("Expected schema to contain %q, got:\n%s", part, sdl)
+		}
+	}
+}
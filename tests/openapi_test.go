@@ -0,0 +1,220 @@
+package tests
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/doruo/falloutdle/cmd/server/handler"
+	"github.com/doruo/falloutdle/pkg/openapi"
+)
+
+// registeredAPIRoutes returns API route patterns registered in SetupRoutes, as OpenAPI paths.
+func registeredAPIRoutes(t *testing.T) []string {
+
+	file, err := parser.ParseFile(token.NewFileSet(), "../cmd/server/handler/routes/routes.go", nil, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse routes: %v", err)
+	}
+
+	var routes []string
+	ast.Inspect(file, func(node ast.Node) bool {
+
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+
+		// Versioned API routes are registered with handleAPI(mux, pattern, handle)
+		var arg ast.Expr
+		prefix := ""
+
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			if fun.Sel.Name != "HandleFunc" {
+				return true
+			}
+			arg = call.Args[0]
+		case *ast.Ident:
+			if fun.Name != "handleAPI" || len(call.Args) < 2 {
+				return true
+			}
+			arg, prefix = call.Args[1], handler.APIVersionPrefix
+		default:
+			return true
+		}
+
+		literal, ok := arg.(*ast.BasicLit)
+		if !ok || literal.Kind != token.STRING {
+			return true
+		}
+
+		pattern, _ := strconv.Unquote(literal.Value)
+		pattern = prefix + pattern
+		if strings.HasPrefix(pattern, "/api/") {
+			// Rx: "/api/v1/characters/by-title/{wikiTitle...}"
+			routes = append(routes, strings.ReplaceAll(pattern, "...}", "}"))
+		}
+
+		return true
+	})
+
+	return routes
+}
+
+func TestOpenAPICoversRoutes(t *testing.T) {
+
+	document, err := handler.OpenAPISpec()
+	if err != nil {
+		t.Fatalf("Invalid OpenAPI document: %v", err)
+	}
+
+	routes := registeredAPIRoutes(t)
+	if len(routes) == 0 {
+		t.Fatal("Expected API routes in SetupRoutes")
+	}
+
+	for _, route := range routes {
+
+		item, exists := document.Paths[route]
+		if !exists {
+			t.Errorf("Route %s is missing from OpenAPI document", route)
+			continue
+		}
+
+		if len(item) == 0 {
+			t.Errorf("Route %s has no operation in OpenAPI document", route)
+		}
+	}
+
+	if _, exists := document.Components.Schemas["Response"]; !exists {
+		t.Error("Expected Response envelope schema")
+	}
+}
+
+func TestOpenAPIValidate(t *testing.T) {
+
+	document, err := handler.OpenAPISpec()
+	if err != nil {
+		t.Fatalf("Invalid OpenAPI document: %v", err)
+	}
+
+	tests := []struct {
+		method string
+		target string
+		body   string
+		status int // 0 if valid
+	}{
+		{http.MethodGet, "/api/v1/characters?limit=10&sort=-name", "", 0},
+		{http.MethodGet, "/api/v1/characters?sort=age", "", http.StatusBadRequest},
+		{http.MethodGet, "/api/v1/characters?limit=ten", "", http.StatusBadRequest},
+		{http.MethodGet, "/api/v1/characters/search", "", http.StatusBadRequest},
+		{http.MethodGet, "/api/v1/characters/search?q=cass", "", 0},
+		{http.MethodGet, "/api/v1/characters/12", "", 0},
+		{http.MethodGet, "/api/v1/characters/abc", "", http.StatusBadRequest},
+		{http.MethodGet, "/api/v1/characters/by-title/Mr._House/Quotes", "", 0},
+		{http.MethodGet, "/api/v1/leaderboard?mode=classic&period=weekly", "", http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/random", "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/api/v1/guess", `{"name":"Cass"}`, 0},
+		{http.MethodPost, "/api/v1/guess", `{"name":""}`, http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/guess", `{"name":12}`, http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/guess", `{`, http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/guess", "", http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/connections/verify", `{"ids":[1,2,3,4]}`, 0},
+		{http.MethodPost, "/api/v1/connections/verify", `{"ids":[1,"2"]}`, http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/higherlower/answer", `{"choice":"c"}`, http.StatusBadRequest},
+		{http.MethodDelete, "/api/v1/leagues/3/members/4", "", 0},
+		{http.MethodGet, "/", "", 0},
+		{http.MethodGet, "/api/unknown", "", 0},
+	}
+
+	for _, test := range tests {
+
+		request := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+		err := document.Validate(request)
+
+		status := 0
+		if invalid, ok := err.(*openapi.ValidationError); ok {
+			status = invalid.Status
+		}
+
+		if status != test.status {
+			t.Errorf("%s %s %s: expected status %d, got %d (%v)", test.method, test.target, test.body, test.status, status, err)
+		}
+	}
+
+	// Deprecated unversioned aliases are validated as versioned routes
+	alias := httptest.NewRequest(http.MethodGet, "/api/characters?sort=age", nil)
+	if err := document.ValidatePath(alias, handler.VersionedPath(alias.URL.Path)); err == nil {
+		t.Error("Expected unversioned alias validated")
+	}
+
+	// Body is still readable by handlers
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/guess", strings.NewReader(`{"name":"Cass"}`))
+	if err := document.Validate(request); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil || string(body) != `{"name":"Cass"}` {
+		t.Errorf("Expected body restored, got %q", body)
+	}
+}
+
+func TestVersionedPath(t *testing.T) {
+
+	tests := map[string]string{
+		"/api/random":         "/api/v1/random",
+		"/api/characters/12":  "/api/v1/characters/12",
+		"/api/v1/random":      "/api/v1/random",
+		"/api/v1":             "/api/v1",
+		"/":                   "/",
+		"/api/v10/characters": "/api/v1/v10/characters",
+	}
+
+	for path, expected := range tests {
+		if got := handler.VersionedPath(path); got != expected {
+			t.Errorf("VersionedPath(%q) = %q, expected %q", path, got, expected)
+		}
+	}
+}
+
+func TestValidationMiddleware(t *testing.T) {
+
+	reached := false
+	server := handler.NewValidationMiddleware().WithValidation(handler.WithDeprecation(func(writer http.ResponseWriter, request *http.Request) {
+		reached = true
+	}))
+
+	// Invalid request on deprecated alias, rejected with status and code
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/random", nil))
+
+	var response handler.Response
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("Invalid response: %v", err)
+	}
+
+	if reached || recorder.Code != http.StatusMethodNotAllowed || response.Success || response.Code != handler.CodeMethodNotAllowed {
+		t.Errorf("Expected method not allowed, got %d %+v", recorder.Code, response)
+	}
+
+	// Valid request reaches handler, deprecated alias points to its versioned route
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/characters/12", nil))
+
+	if !reached {
+		t.Fatal("Expected valid request to reach handler")
+	}
+
+	if recorder.Header().Get("Deprecation") != "true" || !strings.Contains(recorder.Header().Get("Link"), "</api/v1/characters/12>") {
+		t.Errorf("Expected deprecation headers, got %v", recorder.Header())
+	}
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/doruo/falloutdle/internal/player"
+)
+
+func TestCookieSigner(t *testing.T) {
+
+	signer := player.NewCookieSigner([]byte("test_secret"))
+	value := signer.Sign("session_token")
+
+	token, err := signer.Verify(value)
+	if err != nil || token != "session_token" {
+		t.Fatalf("Expected session_token, got %s (%v)", token, err)
+	}
+
+	// Forged token with a valid signature of another one
+	if _, err := signer.Verify("other_token" + value[len("session_token"):]); err != player.ErrInvalidCookie {
+		t.Errorf("Expected %v, got %v", player.ErrInvalidCookie, err)
+	}
+
+	other := player.NewCookieSigner([]byte("other_secret"))
+	if _, err := other.Verify(value); err != player.ErrInvalidCookie {
+		t.Errorf("Expected %v, got %v", player.ErrInvalidCookie, err)
+	}
+}
+
+func TestPasswordHash(t *testing.T) {
+
+	hash := player.HashPassword("vault-tec-101")
+
+	if ok, err := player.VerifyPassword("vault-tec-101", hash); err != nil || !ok {
+		t.Fatalf("Expected password to match, got %v (%v)", ok, err)
+	}
+
+	if ok, _ := player.VerifyPassword("vault-tec-111", hash); ok {
+		t.Errorf("Expected wrong password not to match")
+	}
+
+	// Same password hashed twice has different salts
+	if player.HashPassword("vault-tec-101") == hash {
+		t.Errorf("Expected different hashes for same password")
+	}
+
+	if _, err := player.VerifyPassword("vault-tec-101", "plain"); err != player.ErrInvalidHash {
+		t.Errorf("Expected %v, got %v", player.ErrInvalidHash, err)
+	}
+}
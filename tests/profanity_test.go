@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/doruo/falloutdle/pkg/profanity"
+)
+
+func TestIsProfane(t *testing.T) {
+
+	cases := map[string]bool{
+		"Lone Wanderer":  false,
+		"Dickens":        false,
+		"Courier Six":    false,
+		"Sh1t Happens":   true,
+		"F.U.C.K":        true,
+		"Bîtch":          true,
+		"vault_whore_13": true,
+		"Grape Drape":    false,
+		"Scraper":        false,
+		"Scunthorpe":     false,
+		"Ashkenazi":      false,
+		"Pornic":         false,
+		"Nazis":          true,
+		"R.A.P.E":        true,
+		"porn_king":      true,
+	}
+
+	for name, expected := range cases {
+		if profane := profanity.IsProfane(name); profane != expected {
+			t.Errorf("IsProfane(%q): expected %v, got %v", name, expected, profane)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doruo/falloutdle/pkg/ratelimit"
+)
+
+func TestLimiter(t *testing.T) {
+
+	limiter := ratelimit.NewLimiter(3, 50*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		if !limiter.Allow("client") {
+			t.Fatalf("Expected hit %d to be allowed", i+1)
+		}
+	}
+
+	if limiter.Allow("client") {
+		t.Errorf("Expected hit over limit to be denied")
+	}
+
+	// Keys are limited separately
+	if !limiter.Allow("other") {
+		t.Errorf("Expected other key to be allowed")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if !limiter.Allow("client") {
+		t.Errorf("Expected hit in a new window to be allowed")
+	}
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+func TestShareGuesses(t *testing.T) {
+
+	answer := newTestCharacter(1, "Arcade Gannon")
+	answer.Race = "Human"
+	answer.MainGame = "FNV"
+	answer.Games = []string{"FNV"}
+
+	guess := newTestCharacter(2, "Roger Maxson")
+	guess.Race = "Human"
+	guess.MainGame = "FO1"
+	guess.Games = []string{"FO1"}
+
+	guesses := []*game.GuessResult{
+		game.CompareCharacters(guess, answer),
+		game.CompareCharacters(answer, answer),
+	}
+
+	share := game.ShareGuesses(game.ClassicMode, 42, guesses)
+
+	if !share.Solved || share.Attempts != 2 {
+		t.Errorf("Expected solved in 2, got %v in %d", share.Solved, share.Attempts)
+	}
+
+	lines := strings.Split(share.Text, "\n")
+	if len(lines) != 4 || lines[0] != "Falloutdle #42 Classic 2 guesses" {
+		t.Fatalf("Unexpected share text:\n%s", share.Text)
+	}
+
+	// Answer is newer than guess main game
+	if !strings.Contains(lines[2], "⬆️") {
+		t.Errorf("Expected later hint arrow in %q", lines[2])
+	}
+
+	if lines[3] != strings.Repeat("🟩", len(guesses[1].Attributes)) {
+		t.Errorf("Expected all green row, got %q", lines[3])
+	}
+
+	for _, name := range []string{"Arcade", "Roger"} {
+		if strings.Contains(share.Text, name) {
+			t.Errorf("Share text contains character name %s", name)
+		}
+	}
+}
+
+func TestRenderCard(t *testing.T) {
+
+	card := game.RenderCard(&stats.Result{
+		Mode:    string(game.ClassicMode),
+		Number:  42,
+		Won:     true,
+		Guesses: 2,
+		Grid:    []string{"RGUYD", "GGGGG"},
+		Streak:  3,
+	})
+
+	if card.Bounds().Dx() != game.CardWidth || card.Bounds().Dy() != game.CardHeight {
+		t.Errorf("Expected %dx%d card, got %v", game.CardWidth, game.CardHeight, card.Bounds())
+	}
+
+	// Chronological hints are not drawn as plain wrong cells
+	red := color.RGBA{R: 0xa8, G: 0x32, B: 0x32, A: 0xff}
+	for _, grid := range []string{"U", "D"} {
+
+		card := game.RenderCard(&stats.Result{Mode: string(game.ClassicMode), Grid: []string{grid}})
+
+		if hasColor(card, red) {
+			t.Errorf("Expected %s cell drawn apart from wrong cells", grid)
+		}
+	}
+}
+
+// hasColor determines if any img pixel is of color c.
+func hasColor(img *image.RGBA, c color.RGBA) bool {
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			if img.RGBAAt(x, y) == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestResultSigner(t *testing.T) {
+
+	signer := stats.NewResultSigner([]byte("test_secret"))
+	token := signer.Sign(42)
+
+	// Same result always has the same card URL
+	if signer.Sign(42) != token {
+		t.Errorf("Expected stable token for same ID")
+	}
+
+	if id, err := signer.Verify(token); err != nil || id != 42 {
+		t.Fatalf("Expected 42, got %d (%v)", id, err)
+	}
+
+	// Other ID with a valid signature of another one
+	if _, err := signer.Verify("43" + token[len("42"):]); err != stats.ErrInvalidToken {
+		t.Errorf("Expected %v, got %v", stats.ErrInvalidToken, err)
+	}
+}
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doruo/falloutdle/internal/game"
+	"github.com/doruo/falloutdle/internal/stats"
+)
+
+func TestStatStreak(t *testing.T) {
+
+	stat := stats.NewStat(1, string(game.ClassicMode))
+
+	stat.Add(&stats.Result{Number: 10, Won: true, Guesses: 3})
+	stat.Add(&stats.Result{Number: 11, Won: true, Guesses: 2})
+	stat.Add(&stats.Result{Number: 11, Won: true, Guesses: 1})                 // Already counted
+	stat.Add(&stats.Result{Number: 12, Won: true, Guesses: 4, Practice: true}) // Not counted
+	stat.Add(&stats.Result{Number: 12, Won: true, Guesses: 5})
+
+	if stat.Played != 3 || stat.CurrentStreak != 3 || stat.MaxStreak != 3 {
+		t.Fatalf("Expected 3 played with streak 3, got %+v", stat)
+	}
+
+	// Missed day breaks the streak
+	stat.Add(&stats.Result{Number: 14, Won: true, Guesses: 2})
+	if stat.CurrentStreak != 1 || stat.MaxStreak != 3 || stat.Distribution[2] != 2 {
+		t.Fatalf("Expected streak 1 and max 3, got %+v", stat)
+	}
+
+	if view := stat.View(16); view.CurrentStreak != 0 || view.WinPercentage != 100 {
+		t.Errorf("Expected lost current streak, got %+v", view)
+	}
+
+	stat.Add(&stats.Result{Number: 15, Won: false, Guesses: 6})
+	if stat.CurrentStreak != 0 || stat.View(15).WinPercentage != 80 {
+		t.Errorf("Expected lost streak and 80%% wins, got %+v", stat.View(15))
+	}
+}
+
+func TestPuzzleNumber(t *testing.T) {
+
+	// Same UTC day whatever the player timezone
+	paris := time.FixedZone("UTC+2", 2*60*60)
+	late := time.Date(2025, time.July, 2, 23, 30, 0, 0, time.UTC)
+	early := time.Date(2025, time.July, 3, 1, 0, 0, 0, paris)
+
+	if game.PuzzleNumber(late) != 2 || game.PuzzleNumber(early) != 2 {
+		t.Errorf("Expected puzzle 2, got %d and %d", game.PuzzleNumber(late), game.PuzzleNumber(early))
+	}
+}
+
+func TestWeekNumbers(t *testing.T) {
+
+	// 2025-07-01 is a Tuesday, puzzle 1, so its week starts on puzzle 0
+	sunday := time.Date(2025, time.July, 6, 12, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, time.July, 7, 0, 0, 0, 0, time.UTC)
+
+	if first, last := game.WeekNumbers(sunday); first != 0 || last != 6 {
+		t.Errorf("Expected week 0 to 6, got %d to %d", first, last)
+	}
+
+	if first, last := game.WeekNumbers(monday); first != 7 || last != 13 {
+		t.Errorf("Expected week 7 to 13, got %d to %d", first, last)
+	}
+}
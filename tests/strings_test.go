@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/doruo/falloutdle/pkg/strings"
+)
+
+func TestLevenshtein(t *testing.T) {
+
+	cases := []struct {
+		a, b     string
+		distance int
+	}{
+		{"", "", 0},
+		{"caesar", "caesar", 0},
+		{"caesar", "ceasar", 2},
+		{"benny", "beny", 1},
+		{"", "dogmeat", 7},
+		{"élise", "elise", 1},
+	}
+
+	for _, c := range cases {
+		if distance := strings.Levenshtein(c.a, c.b); distance != c.distance {
+			t.Errorf("Expected distance %d between %q and %q, got %d", c.distance, c.a, c.b, distance)
+		}
+	}
+}
+
+func TestFoldWords(t *testing.T) {
+	if folded := strings.FoldWords("  Mr. Élise-Doe's "); folded != "mr elise does" {
+		t.Errorf("Expected %q, got %q", "mr elise does", folded)
+	}
+}
@@ -60,3 +60,30 @@ func TestMediaWikiClient_FetchAllCharacters(t *testing.T) {
 		t.Fatalf("No characters found")
 	}
 }
+
+func TestMediaWikiClient_ParseCharacterActors(t *testing.T) {
+
+	content := `{{Infobox character
+|name    = Roger Maxson
+|games   = FO1, FO3
+|race    = Human
+|actor   = [[Jim Cummings]]<br>[[Dee Bradley Baker|Dee Baker]] (Fallout 3)
+}}`
+
+	character, err := client.ParseCharacterFromContent(character_name, content)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"Jim Cummings", "Dee Baker"}
+	if len(character.Actors) != len(expected) {
+		t.Fatalf("Expected actors %v, got %v", expected, character.Actors)
+	}
+
+	for i, actor := range expected {
+		if character.Actors[i] != actor {
+			t.Errorf("Expected actor %s, got %s", actor, character.Actors[i])
+		}
+	}
+}